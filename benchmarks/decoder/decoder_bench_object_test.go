@@ -0,0 +1,49 @@
+package benchmarks
+
+import (
+	"testing"
+
+	"github.com/arago-dsp/gojay"
+)
+
+// objectKeysFixture is a flat object with many keys and a few nested objects,
+// its cost is mostly the one of the key loop of the decoder.
+var objectKeysFixture = []byte(`{
+	"k0": 0, "k1": 1, "k2": 2, "k3": 3, "k4": 4, "k5": 5, "k6": 6, "k7": 7, "k8": 8, "k9": 9,
+	"s0": "a", "s1": "b", "s2": "c", "s3": "d", "s4": "e", "s5": "f", "s6": "g", "s7": "h",
+	"o0": {"k0": 0, "k1": 1, "s0": "a"}, "o1": {"k0": 0, "k1": 1, "s0": "a"},
+	"o2": {"k0": 0, "k1": 1, "s0": "a"}, "o3": {"k0": 0, "k1": 1, "s0": "a"},
+	"skip0": true, "skip1": null, "skip2": [1, 2, 3], "skip3": {"x": 1}
+}`)
+
+type objectKeys struct {
+	ints    [10]int
+	strs    [8]string
+	objects [4]*objectKeys
+}
+
+func (o *objectKeys) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+	switch {
+	case len(k) == 2 && k[0] == 'k':
+		return dec.Int(&o.ints[k[1]-'0'])
+	case len(k) == 2 && k[0] == 's':
+		return dec.String(&o.strs[k[1]-'0'])
+	case len(k) == 2 && k[0] == 'o':
+		o.objects[k[1]-'0'] = &objectKeys{}
+		return dec.Object(o.objects[k[1]-'0'])
+	}
+	return nil
+}
+
+func (o *objectKeys) NKeys() int {
+	return 0
+}
+
+func BenchmarkGoJayDecodeObjKeys(b *testing.B) {
+	b.ReportAllocs()
+	b.SetBytes(int64(len(objectKeysFixture)))
+	for n := 0; n < b.N; n++ {
+		result := objectKeys{}
+		gojay.UnmarshalJSONObject(objectKeysFixture, &result)
+	}
+}
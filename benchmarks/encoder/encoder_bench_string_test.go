@@ -0,0 +1,32 @@
+package benchmarks
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/arago-dsp/gojay"
+)
+
+func BenchmarkGoJayEncodeStringASCII(b *testing.B) {
+	s := strings.Repeat("gojay is a performant JSON encoder/decoder. ", 10)
+	for _, bench := range []struct {
+		name string
+		opts []gojay.EncoderOption
+	}{
+		{name: "unchecked"},
+		{name: "utf8-replace", opts: []gojay.EncoderOption{gojay.WithEncoderUTF8Policy(gojay.EncodeUTF8Replace)}},
+		{name: "html-safe", opts: []gojay.EncoderOption{gojay.WithHTMLSafe()}},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			enc := gojay.NewEncoder(io.Discard, bench.opts...)
+			b.ReportAllocs()
+			b.SetBytes(int64(len(s)))
+			for i := 0; i < b.N; i++ {
+				if err := enc.EncodeString(s); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
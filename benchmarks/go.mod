@@ -1,6 +1,6 @@
 module github.com/arago-dsp/gojay/benchmarks
 
-go 1.22
+go 1.23
 
 require (
 	github.com/arago-dsp/gojay v1.2.13
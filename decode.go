@@ -11,8 +11,8 @@ import (
 //
 // If a JSON value is not appropriate for a given target type, or if a JSON number
 // overflows the target type, UnmarshalJSONArray skips that field and completes the unmarshalling as best it can.
-func UnmarshalJSONArray(data []byte, v UnmarshalerJSONArray) error {
-	dec := borrowDecoder(nil, 0)
+func UnmarshalJSONArray(data []byte, v UnmarshalerJSONArray, opts ...DecoderOption) error {
+	dec := borrowDecoder(nil, 0, opts...)
 	defer dec.Release()
 	dec.data = make([]byte, len(data))
 	copy(dec.data, data)
@@ -33,8 +33,8 @@ func UnmarshalJSONArray(data []byte, v UnmarshalerJSONArray) error {
 //
 // If a JSON value is not appropriate for a given target type, or if a JSON number
 // overflows the target type, UnmarshalJSONObject skips that field and completes the unmarshalling as best it can.
-func UnmarshalJSONObject(data []byte, v UnmarshalerJSONObject) error {
-	dec := borrowDecoder(nil, 0)
+func UnmarshalJSONObject(data []byte, v UnmarshalerJSONObject, opts ...DecoderOption) error {
+	dec := borrowDecoder(nil, 0, opts...)
 	defer dec.Release()
 	dec.data = make([]byte, len(data))
 	copy(dec.data, data)
@@ -49,6 +49,14 @@ func UnmarshalJSONObject(data []byte, v UnmarshalerJSONObject) error {
 	return nil
 }
 
+// UnmarshalStrict is Unmarshal in strict mode, it fails with an UnknownKeyError
+// as soon as an object has a key which is not decoded by its UnmarshalJSONObject method.
+//
+// See WithStrictAllow to skip some unknown keys.
+func UnmarshalStrict(data []byte, v any, opts ...DecoderOption) error {
+	return Unmarshal(data, v, append([]DecoderOption{WithStrict()}, opts...)...)
+}
+
 // Unmarshal parses the JSON-encoded data and stores the result in the value pointed to by v.
 // If v is nil, not an implementation of UnmarshalerJSONObject or UnmarshalerJSONArray or not one of the following types:
 //
@@ -63,155 +71,155 @@ func UnmarshalJSONObject(data []byte, v UnmarshalerJSONObject) error {
 // In any case, it's not guaranteed that all the remaining fields following the problematic one will be unmarshalled into the target object.
 //
 //nolint:funlen,cyclop,gocyclo
-func Unmarshal(data []byte, v any) error {
+func Unmarshal(data []byte, v any, opts ...DecoderOption) error {
 	var err error
 	var dec *Decoder
 	switch vt := v.(type) {
 	case *string:
-		dec = borrowDecoder(nil, 0)
+		dec = borrowDecoder(nil, 0, opts...)
 		dec.length = len(data)
 		dec.data = make([]byte, len(data))
 		copy(dec.data, data)
 		err = dec.decodeString(vt)
 	case **string:
-		dec = borrowDecoder(nil, 0)
+		dec = borrowDecoder(nil, 0, opts...)
 		dec.length = len(data)
 		dec.data = data
 		err = dec.decodeStringNull(vt)
 	case *int:
-		dec = borrowDecoder(nil, 0)
+		dec = borrowDecoder(nil, 0, opts...)
 		dec.length = len(data)
 		dec.data = data
 		err = dec.decodeInt(vt)
 	case **int:
-		dec = borrowDecoder(nil, 0)
+		dec = borrowDecoder(nil, 0, opts...)
 		dec.length = len(data)
 		dec.data = data
 		err = dec.decodeIntNull(vt)
 	case *int8:
-		dec = borrowDecoder(nil, 0)
+		dec = borrowDecoder(nil, 0, opts...)
 		dec.length = len(data)
 		dec.data = data
 		err = dec.decodeInt8(vt)
 	case **int8:
-		dec = borrowDecoder(nil, 0)
+		dec = borrowDecoder(nil, 0, opts...)
 		dec.length = len(data)
 		dec.data = data
 		err = dec.decodeInt8Null(vt)
 	case *int16:
-		dec = borrowDecoder(nil, 0)
+		dec = borrowDecoder(nil, 0, opts...)
 		dec.length = len(data)
 		dec.data = data
 		err = dec.decodeInt16(vt)
 	case **int16:
-		dec = borrowDecoder(nil, 0)
+		dec = borrowDecoder(nil, 0, opts...)
 		dec.length = len(data)
 		dec.data = data
 		err = dec.decodeInt16Null(vt)
 	case *int32:
-		dec = borrowDecoder(nil, 0)
+		dec = borrowDecoder(nil, 0, opts...)
 		dec.length = len(data)
 		dec.data = data
 		err = dec.decodeInt32(vt)
 	case **int32:
-		dec = borrowDecoder(nil, 0)
+		dec = borrowDecoder(nil, 0, opts...)
 		dec.length = len(data)
 		dec.data = data
 		err = dec.decodeInt32Null(vt)
 	case *int64:
-		dec = borrowDecoder(nil, 0)
+		dec = borrowDecoder(nil, 0, opts...)
 		dec.length = len(data)
 		dec.data = data
 		err = dec.decodeInt64(vt)
 	case **int64:
-		dec = borrowDecoder(nil, 0)
+		dec = borrowDecoder(nil, 0, opts...)
 		dec.length = len(data)
 		dec.data = data
 		err = dec.decodeInt64Null(vt)
 	case *uint8:
-		dec = borrowDecoder(nil, 0)
+		dec = borrowDecoder(nil, 0, opts...)
 		dec.length = len(data)
 		dec.data = data
 		err = dec.decodeUint8(vt)
 	case **uint8:
-		dec = borrowDecoder(nil, 0)
+		dec = borrowDecoder(nil, 0, opts...)
 		dec.length = len(data)
 		dec.data = data
 		err = dec.decodeUint8Null(vt)
 	case *uint16:
-		dec = borrowDecoder(nil, 0)
+		dec = borrowDecoder(nil, 0, opts...)
 		dec.length = len(data)
 		dec.data = data
 		err = dec.decodeUint16(vt)
 	case **uint16:
-		dec = borrowDecoder(nil, 0)
+		dec = borrowDecoder(nil, 0, opts...)
 		dec.length = len(data)
 		dec.data = data
 		err = dec.decodeUint16Null(vt)
 	case *uint32:
-		dec = borrowDecoder(nil, 0)
+		dec = borrowDecoder(nil, 0, opts...)
 		dec.length = len(data)
 		dec.data = data
 		err = dec.decodeUint32(vt)
 	case **uint32:
-		dec = borrowDecoder(nil, 0)
+		dec = borrowDecoder(nil, 0, opts...)
 		dec.length = len(data)
 		dec.data = data
 		err = dec.decodeUint32Null(vt)
 	case *uint64:
-		dec = borrowDecoder(nil, 0)
+		dec = borrowDecoder(nil, 0, opts...)
 		dec.length = len(data)
 		dec.data = data
 		err = dec.decodeUint64(vt)
 	case **uint64:
-		dec = borrowDecoder(nil, 0)
+		dec = borrowDecoder(nil, 0, opts...)
 		dec.length = len(data)
 		dec.data = data
 		err = dec.decodeUint64Null(vt)
 	case *float64:
-		dec = borrowDecoder(nil, 0)
+		dec = borrowDecoder(nil, 0, opts...)
 		dec.length = len(data)
 		dec.data = data
 		err = dec.decodeFloat64(vt)
 	case **float64:
-		dec = borrowDecoder(nil, 0)
+		dec = borrowDecoder(nil, 0, opts...)
 		dec.length = len(data)
 		dec.data = data
 		err = dec.decodeFloat64Null(vt)
 	case *float32:
-		dec = borrowDecoder(nil, 0)
+		dec = borrowDecoder(nil, 0, opts...)
 		dec.length = len(data)
 		dec.data = data
 		err = dec.decodeFloat32(vt)
 	case **float32:
-		dec = borrowDecoder(nil, 0)
+		dec = borrowDecoder(nil, 0, opts...)
 		dec.length = len(data)
 		dec.data = data
 		err = dec.decodeFloat32Null(vt)
 	case *bool:
-		dec = borrowDecoder(nil, 0)
+		dec = borrowDecoder(nil, 0, opts...)
 		dec.length = len(data)
 		dec.data = data
 		err = dec.decodeBool(vt)
 	case **bool:
-		dec = borrowDecoder(nil, 0)
+		dec = borrowDecoder(nil, 0, opts...)
 		dec.length = len(data)
 		dec.data = data
 		err = dec.decodeBoolNull(vt)
 	case UnmarshalerJSONObject:
-		dec = borrowDecoder(nil, 0)
+		dec = borrowDecoder(nil, 0, opts...)
 		dec.length = len(data)
 		dec.data = make([]byte, len(data))
 		copy(dec.data, data)
 		_, err = dec.decodeObject(vt)
 	case UnmarshalerJSONArray:
-		dec = borrowDecoder(nil, 0)
+		dec = borrowDecoder(nil, 0, opts...)
 		dec.length = len(data)
 		dec.data = make([]byte, len(data))
 		copy(dec.data, data)
 		_, err = dec.decodeArray(vt)
 	case *any:
-		dec = borrowDecoder(nil, 0)
+		dec = borrowDecoder(nil, 0, opts...)
 		dec.length = len(data)
 		dec.data = make([]byte, len(data))
 		copy(dec.data, data)
@@ -227,6 +235,7 @@ func Unmarshal(data []byte, v any) error {
 }
 
 // UnmarshalerJSONObject is the interface to implement to decode a JSON Object.
+// UnmarshalJSONObject is called with each key of the object, escape sequences decoded.
 type UnmarshalerJSONObject interface {
 	UnmarshalJSONObject(dec *Decoder, s string) error
 	NKeys() int
@@ -249,6 +258,36 @@ type Decoder struct {
 	length     int
 	keysDone   int
 	arrayIndex int
+	depth      int
+	maxDepth   int
+	useNumber  bool
+	// reuseBuffer makes compact recycle the buffer in place
+	reuseBuffer bool
+	// strict rejects the keys not decoded by UnmarshalJSONObject unless allowUnknownKey returns true
+	strict          bool
+	allowUnknownKey func(path, key string) bool
+	duplicateKeys   DuplicateKeyPolicy
+	utf8Policy      UTF8Policy
+	// limits of the input read from r, readErr is the error which stopped reading
+	maxBytes       int
+	maxTokenLength int
+	maxBufferSize  int
+	maxBigExponent int
+	bytesRead      int
+	tokenStart     int
+	readErr        error
+	// pos is the position in the input of the byte posAt of data,
+	// escapes and path are used to locate errors
+	pos     inputPos
+	posAt   int
+	escapes []escapeShift
+	path    []pathElem
+	// tokens is the stack of the objects and arrays opened by Token,
+	// valueAt is the cursor at the value of the last key read by Token, 0 once the value is read
+	tokens  []tokenFrame
+	valueAt int
+	// tokenPrev is the last char which is not a space of the data discarded by Token
+	tokenPrev byte
 }
 
 // Decode reads the next JSON-encoded value from the decoder's input (io.Reader)
@@ -265,6 +304,7 @@ func (dec *Decoder) Decode(v any) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	var err error
 	switch vt := v.(type) {
 	case *string:
@@ -347,26 +387,35 @@ func isDigit(b byte) bool {
 	}
 }
 
+//nolint:cyclop
 func (dec *Decoder) read() bool {
 	//nolint:nestif
 	if dec.r != nil {
+		if dec.readErr != nil {
+			return false
+		}
 		// if we reach the end, double the buffer to ensure there's always more space
-		if len(dec.data) == dec.length {
-			nLen := dec.length * 2
-			if nLen == 0 {
-				nLen = 512
-			}
-			buf := make([]byte, nLen)
-			copy(buf, dec.data)
-			dec.data = buf
+		if len(dec.data) == dec.length && !dec.grow() {
+			return false
+		}
+		buf := dec.data[dec.length:]
+		if dec.maxBytes > 0 {
+			// read one byte more than allowed to find out if the input is too large
+			buf = buf[:min(len(buf), dec.maxBytes-dec.bytesRead+1)]
 		}
 		var n int
 		var err error
 		for n == 0 {
-			n, err = dec.r.Read(dec.data[dec.length:])
+			n, err = dec.r.Read(buf)
+			dec.bytesRead += n
+			if dec.maxBytes > 0 && dec.bytesRead > dec.maxBytes {
+				dec.raiseReadErr(MaxBytesError(fmt.Sprintf(maxBytesErrorMsg, dec.maxBytes)), dec.length)
+				return false
+			}
 			if err != nil {
 				if err != io.EOF {
 					dec.err = err
+					dec.readErr = err
 					return false
 				}
 				if n == 0 {
@@ -382,6 +431,33 @@ func (dec *Decoder) read() bool {
 	return false
 }
 
+// grow doubles the size of the buffer within the limit of the maximum buffer size.
+func (dec *Decoder) grow() bool {
+	// the buffer may have been shrunk by compact, do not grow it from a few bytes
+	nLen := max(dec.length*2, 512)
+	if dec.maxBufferSize > 0 && nLen > dec.maxBufferSize {
+		if dec.length >= dec.maxBufferSize {
+			dec.raiseReadErr(MaxBufferSizeError(fmt.Sprintf(maxBufferSizeErrorMsg, dec.maxBufferSize)), dec.length)
+			return false
+		}
+		nLen = dec.maxBufferSize
+	}
+	buf := make([]byte, nLen)
+	copy(buf, dec.data)
+	dec.data = buf
+	return true
+}
+
+// readToken reads more data for a string or number token starting at tokenStart,
+// it fails if the token is already longer than the maximum token length.
+func (dec *Decoder) readToken() bool {
+	if dec.maxTokenLength > 0 && dec.length-dec.tokenStart > dec.maxTokenLength {
+		dec.raiseReadErr(MaxTokenLengthError(fmt.Sprintf(maxTokenLengthErrorMsg, dec.maxTokenLength)), dec.tokenStart)
+		return false
+	}
+	return dec.read()
+}
+
 func (dec *Decoder) nextChar() byte {
 	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
 		switch dec.data[dec.cursor] {
@@ -393,3 +469,16 @@ func (dec *Decoder) nextChar() byte {
 	}
 	return 0
 }
+
+// skipSpaces moves the cursor to the next non white space char and returns it,
+// contrary to nextChar, commas are not skipped. It returns 0 if the input is exhausted.
+func (dec *Decoder) skipSpaces() byte {
+	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
+		switch dec.data[dec.cursor] {
+		case ' ', '\n', '\t', '\r':
+			continue
+		}
+		return dec.data[dec.cursor]
+	}
+	return 0
+}
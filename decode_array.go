@@ -12,22 +12,31 @@ func (dec *Decoder) DecodeArray(v UnmarshalerJSONArray) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	_, err := dec.decodeArray(v)
 	return err
 }
 
 func (dec *Decoder) decodeArray(arr UnmarshalerJSONArray) (int, error) {
-	// remember last array index in case of nested arrays
+	// remember last array index, path and nesting depth in case of nested arrays
 	lastArrayIndex := dec.arrayIndex
+	depth := len(dec.path)
+	nesting := dec.depth
 	dec.arrayIndex = 0
 	defer func() {
 		dec.arrayIndex = lastArrayIndex
+		dec.path = dec.path[:depth]
+		dec.depth = nesting
 	}()
 	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
 		switch dec.data[dec.cursor] {
 		case ' ', '\n', '\t', '\r', ',':
 			continue
 		case '[':
+			dec.depth++
+			if err := dec.checkDepth(dec.depth, dec.cursor); err != nil {
+				return 0, err
+			}
 			dec.cursor++
 			// array is open, char is not space start readings
 			for dec.nextChar() != 0 {
@@ -37,6 +46,7 @@ func (dec *Decoder) decodeArray(arr UnmarshalerJSONArray) (int, error) {
 					return dec.cursor, nil
 				}
 				// calling unmarshall function for each element of the slice
+				dec.setPath(depth, pathElem{index: dec.arrayIndex})
 				err := arr.UnmarshalJSONArray(dec)
 				if err != nil {
 					return 0, err
@@ -70,11 +80,15 @@ func (dec *Decoder) decodeArray(arr UnmarshalerJSONArray) (int, error) {
 
 //nolint:funlen,cyclop
 func (dec *Decoder) decodeArrayNull(v any) (int, error) {
-	// remember last array index in case of nested arrays
+	// remember last array index, path and nesting depth in case of nested arrays
 	lastArrayIndex := dec.arrayIndex
+	depth := len(dec.path)
+	nesting := dec.depth
 	dec.arrayIndex = 0
 	defer func() {
 		dec.arrayIndex = lastArrayIndex
+		dec.path = dec.path[:depth]
+		dec.depth = nesting
 	}()
 	vv := reflect.ValueOf(v)
 	vvt := vv.Type()
@@ -89,6 +103,10 @@ func (dec *Decoder) decodeArrayNull(v any) (int, error) {
 		case ' ', '\n', '\t', '\r', ',':
 			continue
 		case '[':
+			dec.depth++
+			if err := dec.checkDepth(dec.depth, dec.cursor); err != nil {
+				return 0, err
+			}
 			dec.cursor++
 			// create our new type
 			elt := vv.Elem()
@@ -108,6 +126,7 @@ func (dec *Decoder) decodeArrayNull(v any) (int, error) {
 					return dec.cursor, nil
 				}
 				// calling unmarshall function for each element of the slice
+				dec.setPath(depth, pathElem{index: dec.arrayIndex})
 				err := arr.UnmarshalJSONArray(dec)
 				if err != nil {
 					return 0, err
@@ -143,18 +162,32 @@ func (dec *Decoder) decodeArrayNull(v any) (int, error) {
 func (dec *Decoder) skipArray() (int, error) {
 	arraysOpen := 1
 	arraysClosed := 0
+	// nesting counts objects and arrays open to enforce the maximum depth
+	nesting := 1
+	if err := dec.checkDepth(dec.depth+nesting, dec.cursor-1); err != nil {
+		return 0, err
+	}
 	// var stringOpen byte = 0
 	for j := dec.cursor; j < dec.length || dec.read(); j++ {
 		switch dec.data[j] {
 		case ']':
+			nesting--
 			arraysClosed++
 			// everything is closed return
 			if arraysOpen == arraysClosed {
 				// add char to object data
 				return j + 1, nil
 			}
-		case '[':
-			arraysOpen++
+		case '[', '{':
+			nesting++
+			if err := dec.checkDepth(dec.depth+nesting, j); err != nil {
+				return 0, err
+			}
+			if dec.data[j] == '[' {
+				arraysOpen++
+			}
+		case '}':
+			nesting--
 		case '"':
 			j++
 			var isInEscapeSeq bool
@@ -1,6 +1,7 @@
 package gojay
 
 import (
+	"errors"
 	"strings"
 	"testing"
 
@@ -60,14 +61,14 @@ func TestSliceInts(t *testing.T) {
 			json:           `[1,2,3,43567788543,457.7765,432,0,"test"]`,
 			expectedResult: testSliceInts{1, 2, 3, 43567788543, 457, 432, 0, 0},
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "invalid-json",
 			json:           `[1,2,3",43567788543,457.7765,432,0,"test"]`,
 			expectedResult: testSliceInts{1, 2, 3, 43567788543, 457, 432, 0, 0},
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 	}
 
@@ -79,7 +80,7 @@ func TestSliceInts(t *testing.T) {
 		if testCase.err {
 			require.Error(t, err)
 			if testCase.errType != nil {
-				assert.IsType(t, testCase.errType, err, "err should be of the given type")
+				assert.ErrorAs(t, err, testCase.errType, "err should be of the given type")
 			}
 			continue
 		}
@@ -130,14 +131,14 @@ func TestSliceStrings(t *testing.T) {
 			json:           `["foo",1,2,3,"test"]`,
 			expectedResult: testSliceStrings{},
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "invalid-json",
 			json:           `["hello world]`,
 			expectedResult: testSliceStrings{},
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 	}
 
@@ -152,7 +153,7 @@ func TestSliceStrings(t *testing.T) {
 			if testCase.err {
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(t, testCase.errType, err, "err should be of the given type")
+					assert.ErrorAs(t, err, testCase.errType, "err should be of the given type")
 				}
 				return
 			}
@@ -202,14 +203,14 @@ func TestSliceBools(t *testing.T) {
 			json:           `["foo",1,2,3,"test"]`,
 			expectedResult: testSliceBools{},
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "invalid-json",
 			json:           `["hello world]`,
 			expectedResult: testSliceBools{},
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 	}
 
@@ -224,7 +225,7 @@ func TestSliceBools(t *testing.T) {
 			if testCase.err {
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(t, testCase.errType, err, "err should be of the given type")
+					assert.ErrorAs(t, err, testCase.errType, "err should be of the given type")
 				}
 				return
 			}
@@ -272,14 +273,14 @@ func TestSliceSlices(t *testing.T) {
 			json:           `["foo",1,2,3,"test"]`,
 			expectedResult: testSliceSlicesSlices{},
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "invalid-json",
 			json:           `["hello world]`,
 			expectedResult: testSliceSlicesSlices{},
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 	}
 
@@ -294,7 +295,7 @@ func TestSliceSlices(t *testing.T) {
 			if testCase.err {
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(t, testCase.errType, err, "err should be of the given type")
+					assert.ErrorAs(t, err, testCase.errType, "err should be of the given type")
 				}
 				return
 			}
@@ -358,14 +359,14 @@ func TestSliceObjects(t *testing.T) {
 			json:           `["foo",1,2,3,"test"]`,
 			expectedResult: testSliceObjects{},
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "invalid-json",
 			json:           `["hello world]`,
 			expectedResult: testSliceObjects{},
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 	}
 
@@ -380,7 +381,7 @@ func TestSliceObjects(t *testing.T) {
 			if testCase.err {
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(t, testCase.errType, err, "err should be of the given type")
+					assert.ErrorAs(t, err, testCase.errType, "err should be of the given type")
 				}
 				return
 			}
@@ -495,8 +496,8 @@ func TestDecodeSliceInvalidType(t *testing.T) {
 	result := testSliceObjects{}
 	err := UnmarshalJSONArray([]byte(`{}`), &result)
 	require.Error(t, err)
-	assert.IsType(t, InvalidUnmarshalError(""), err, "err should be of type InvalidUnmarshalError")
-	assert.Equal(t, "Cannot unmarshal JSON to type '*gojay.testSliceObjects'", err.Error(), "err should not be nil")
+	assert.ErrorAs(t, err, new(InvalidUnmarshalError), "err should be of type InvalidUnmarshalError")
+	assert.Equal(t, "Cannot unmarshal JSON to type '*gojay.testSliceObjects'", errors.Unwrap(err).Error(), "err should not be nil")
 }
 
 func TestDecoderChannelOfObjectsBasic(t *testing.T) {
@@ -540,7 +541,7 @@ func TestDecoderSliceInvalidJSON(t *testing.T) {
 	testArr := testSliceInts{}
 	err := UnmarshalJSONArray(json, &testArr)
 	require.Error(t, err, "Err must not be nil as JSON is invalid")
-	assert.IsType(t, InvalidJSONError(""), err, "err message must be 'Invalid JSON'")
+	assert.ErrorAs(t, err, new(InvalidJSONError), "err message must be 'Invalid JSON'")
 }
 
 func TestDecoderSliceDecoderAPI(t *testing.T) {
@@ -563,7 +564,7 @@ func TestDecoderSliceDecoderAPIError(t *testing.T) {
 	dec := NewDecoder(strings.NewReader(`hello`))
 	err := dec.DecodeArray(&testArr)
 	require.Error(t, err, "Err must not be nil as JSON is invalid")
-	assert.IsType(t, InvalidJSONError(""), err, "err message must be 'Invalid JSON'")
+	assert.ErrorAs(t, err, new(InvalidJSONError), "err message must be 'Invalid JSON'")
 }
 
 func TestUnmarshalJSONArrays(t *testing.T) {
@@ -606,7 +607,7 @@ func TestUnmarshalJSONArrays(t *testing.T) {
 			name: "test decode object null",
 			expectations: func(err error, _ any, t *testing.T) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err must be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err must be of type InvalidJSONError")
 			},
 		},
 	}
@@ -679,7 +680,7 @@ func TestDecodeArrayEmpty(t *testing.T) {
 	dec := NewDecoder(strings.NewReader(""))
 	err := dec.Decode(v)
 	require.Error(t, err)
-	assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+	assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 }
 
 func TestDecodeArraySkipError(t *testing.T) {
@@ -689,7 +690,7 @@ func TestDecodeArraySkipError(t *testing.T) {
 	dec := NewDecoder(strings.NewReader("34fef"))
 	err := dec.Decode(v)
 	require.Error(t, err)
-	assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+	assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 }
 
 func TestDecodeArrayNullError(t *testing.T) {
@@ -699,7 +700,7 @@ func TestDecodeArrayNullError(t *testing.T) {
 	dec := NewDecoder(strings.NewReader("nall"))
 	err := dec.Decode(v)
 	require.Error(t, err)
-	assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+	assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 }
 
 func TestDecoderArrayFunc(t *testing.T) {
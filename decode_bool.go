@@ -8,6 +8,7 @@ func (dec *Decoder) DecodeBool(v *bool) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	return dec.decodeBool(v)
 }
 
@@ -46,7 +46,7 @@ func TestDecoderBool(t *testing.T) {
 			json: "taue",
 			expectations: func(t *testing.T, v bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.False(t, v, "result should be false")
 			},
 		},
@@ -55,7 +55,7 @@ func TestDecoderBool(t *testing.T) {
 			json: "trae",
 			expectations: func(t *testing.T, v bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.False(t, v, "result should be false")
 			},
 		},
@@ -64,7 +64,7 @@ func TestDecoderBool(t *testing.T) {
 			json: "trua",
 			expectations: func(t *testing.T, v bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.False(t, v, "result should be false")
 			},
 		},
@@ -73,7 +73,7 @@ func TestDecoderBool(t *testing.T) {
 			json: "truea",
 			expectations: func(t *testing.T, v bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.False(t, v, "result should be false")
 			},
 		},
@@ -82,7 +82,7 @@ func TestDecoderBool(t *testing.T) {
 			json: "t",
 			expectations: func(t *testing.T, v bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.False(t, v, "result should be false")
 			},
 		},
@@ -91,7 +91,7 @@ func TestDecoderBool(t *testing.T) {
 			json: "a",
 			expectations: func(t *testing.T, v bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.False(t, v, "result should be false")
 			},
 		},
@@ -100,7 +100,7 @@ func TestDecoderBool(t *testing.T) {
 			json: "fulse",
 			expectations: func(t *testing.T, v bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.False(t, v, "result should be false")
 			},
 		},
@@ -109,7 +109,7 @@ func TestDecoderBool(t *testing.T) {
 			json: "fause",
 			expectations: func(t *testing.T, v bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.False(t, v, "result should be false")
 			},
 		},
@@ -118,7 +118,7 @@ func TestDecoderBool(t *testing.T) {
 			json: "falze",
 			expectations: func(t *testing.T, v bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.False(t, v, "result should be false")
 			},
 		},
@@ -127,7 +127,7 @@ func TestDecoderBool(t *testing.T) {
 			json: "falso",
 			expectations: func(t *testing.T, v bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.False(t, v, "result should be false")
 			},
 		},
@@ -136,7 +136,7 @@ func TestDecoderBool(t *testing.T) {
 			json: "falsea",
 			expectations: func(t *testing.T, v bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.False(t, v, "result should be false")
 			},
 		},
@@ -145,7 +145,7 @@ func TestDecoderBool(t *testing.T) {
 			json: "f",
 			expectations: func(t *testing.T, v bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.False(t, v, "result should be false")
 			},
 		},
@@ -154,7 +154,7 @@ func TestDecoderBool(t *testing.T) {
 			json: "a",
 			expectations: func(t *testing.T, v bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.False(t, v, "result should be false")
 			},
 		},
@@ -163,7 +163,7 @@ func TestDecoderBool(t *testing.T) {
 			json: "nall",
 			expectations: func(t *testing.T, v bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.False(t, v, "result should be false")
 			},
 		},
@@ -172,7 +172,7 @@ func TestDecoderBool(t *testing.T) {
 			json: "nual",
 			expectations: func(t *testing.T, v bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.False(t, v, "result should be false")
 			},
 		},
@@ -181,7 +181,7 @@ func TestDecoderBool(t *testing.T) {
 			json: "nula",
 			expectations: func(t *testing.T, v bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.False(t, v, "result should be false")
 			},
 		},
@@ -190,7 +190,7 @@ func TestDecoderBool(t *testing.T) {
 			json: "nulle",
 			expectations: func(t *testing.T, v bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.False(t, v, "result should be false")
 			},
 		},
@@ -199,7 +199,7 @@ func TestDecoderBool(t *testing.T) {
 			json: "n",
 			expectations: func(t *testing.T, v bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.False(t, v, "result should be false")
 			},
 		},
@@ -208,7 +208,7 @@ func TestDecoderBool(t *testing.T) {
 			json: "a",
 			expectations: func(t *testing.T, v bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.False(t, v, "result should be false")
 			},
 		},
@@ -217,7 +217,7 @@ func TestDecoderBool(t *testing.T) {
 			json: "{}",
 			expectations: func(t *testing.T, v bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidUnmarshalError(""), err, "err should be of type InvalidUnmarshalError")
+				assert.ErrorAs(t, err, new(InvalidUnmarshalError), "err should be of type InvalidUnmarshalError")
 				assert.False(t, v, "result should be false")
 			},
 		},
@@ -281,7 +281,7 @@ func TestDecoderBoolNull(t *testing.T) {
 			json: "taue",
 			expectations: func(t *testing.T, v *bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.Nil(t, v, "result should be false")
 			},
 		},
@@ -290,7 +290,7 @@ func TestDecoderBoolNull(t *testing.T) {
 			json: "trae",
 			expectations: func(t *testing.T, v *bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.Nil(t, v, "result should be nil")
 			},
 		},
@@ -299,7 +299,7 @@ func TestDecoderBoolNull(t *testing.T) {
 			json: "trua",
 			expectations: func(t *testing.T, v *bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.Nil(t, v, "result should be nil")
 			},
 		},
@@ -308,7 +308,7 @@ func TestDecoderBoolNull(t *testing.T) {
 			json: "truea",
 			expectations: func(t *testing.T, v *bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.Nil(t, v, "result should be nil")
 			},
 		},
@@ -317,7 +317,7 @@ func TestDecoderBoolNull(t *testing.T) {
 			json: "t",
 			expectations: func(t *testing.T, v *bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.Nil(t, v, "result should be nil")
 			},
 		},
@@ -326,7 +326,7 @@ func TestDecoderBoolNull(t *testing.T) {
 			json: "a",
 			expectations: func(t *testing.T, v *bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.Nil(t, v, "result should be nil")
 			},
 		},
@@ -335,7 +335,7 @@ func TestDecoderBoolNull(t *testing.T) {
 			json: "fulse",
 			expectations: func(t *testing.T, v *bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.Nil(t, v, "result should be nil")
 			},
 		},
@@ -344,7 +344,7 @@ func TestDecoderBoolNull(t *testing.T) {
 			json: "fause",
 			expectations: func(t *testing.T, v *bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.Nil(t, v, "result should be nil")
 			},
 		},
@@ -353,7 +353,7 @@ func TestDecoderBoolNull(t *testing.T) {
 			json: "falze",
 			expectations: func(t *testing.T, v *bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.Nil(t, v, "result should be nil")
 			},
 		},
@@ -362,7 +362,7 @@ func TestDecoderBoolNull(t *testing.T) {
 			json: "falso",
 			expectations: func(t *testing.T, v *bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.Nil(t, v, "result should be nil")
 			},
 		},
@@ -371,7 +371,7 @@ func TestDecoderBoolNull(t *testing.T) {
 			json: "falsea",
 			expectations: func(t *testing.T, v *bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.Nil(t, v, "result should be nil")
 			},
 		},
@@ -380,7 +380,7 @@ func TestDecoderBoolNull(t *testing.T) {
 			json: "f",
 			expectations: func(t *testing.T, v *bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.Nil(t, v, "result should be nil")
 			},
 		},
@@ -389,7 +389,7 @@ func TestDecoderBoolNull(t *testing.T) {
 			json: "a",
 			expectations: func(t *testing.T, v *bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.Nil(t, v, "result should be nil")
 			},
 		},
@@ -398,7 +398,7 @@ func TestDecoderBoolNull(t *testing.T) {
 			json: "nall",
 			expectations: func(t *testing.T, v *bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.Nil(t, v, "result should be nil")
 			},
 		},
@@ -407,7 +407,7 @@ func TestDecoderBoolNull(t *testing.T) {
 			json: "nual",
 			expectations: func(t *testing.T, v *bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.Nil(t, v, "result should be nil")
 			},
 		},
@@ -416,7 +416,7 @@ func TestDecoderBoolNull(t *testing.T) {
 			json: "nula",
 			expectations: func(t *testing.T, v *bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.Nil(t, v, "result should be nil")
 			},
 		},
@@ -425,7 +425,7 @@ func TestDecoderBoolNull(t *testing.T) {
 			json: "nulle",
 			expectations: func(t *testing.T, v *bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.Nil(t, v, "result should be nil")
 			},
 		},
@@ -434,7 +434,7 @@ func TestDecoderBoolNull(t *testing.T) {
 			json: "n",
 			expectations: func(t *testing.T, v *bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.Nil(t, v, "result should be nil")
 			},
 		},
@@ -443,7 +443,7 @@ func TestDecoderBoolNull(t *testing.T) {
 			json: "a",
 			expectations: func(t *testing.T, v *bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 				assert.Nil(t, v, "result should be nil")
 			},
 		},
@@ -452,7 +452,7 @@ func TestDecoderBoolNull(t *testing.T) {
 			json: "{}",
 			expectations: func(t *testing.T, v *bool, err error) {
 				require.Error(t, err)
-				assert.IsType(t, InvalidUnmarshalError(""), err, "err should be of type InvalidUnmarshalError")
+				assert.ErrorAs(t, err, new(InvalidUnmarshalError), "err should be of type InvalidUnmarshalError")
 				assert.Nil(t, v, "result should be nil")
 			},
 		},
@@ -484,7 +484,7 @@ func TestDecoderBoolNull(t *testing.T) {
 		dec := NewDecoder(strings.NewReader(`folse`))
 		err := dec.BoolNull(&v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 }
 
@@ -0,0 +1,165 @@
+package gojay
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+const invalidBase64ErrorMsg = "Cannot unmarshal invalid base64 data to type '%T'"
+
+// DecodeBytes reads the next JSON-encoded value from the decoder's input (io.Reader),
+// decodes the base64 string with the given encoding and stores the result in the []byte pointed to by v.
+// The capacity of *v is reused, a nil encoding is base64.StdEncoding, the one used by encoding/json.
+//
+// See the documentation for Unmarshal for details about the conversion of JSON into a Go value.
+func (dec *Decoder) DecodeBytes(v *[]byte, encoding *base64.Encoding) error {
+	if dec.isPooled == 1 {
+		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
+	}
+	dec.compact()
+	return dec.decodeBytes(v, encoding)
+}
+
+func (dec *Decoder) decodeBytes(v *[]byte, encoding *base64.Encoding) error {
+	start, end, ok, err := dec.getBytesString(v)
+	if !ok {
+		return err
+	}
+	dec.decodeBase64(v, v, encoding, start, end)
+	return nil
+}
+
+func (dec *Decoder) decodeBytesNull(v **[]byte, encoding *base64.Encoding) error {
+	start, end, ok, err := dec.getBytesString(v)
+	if !ok {
+		return err
+	}
+	if *v == nil {
+		*v = new([]byte)
+	}
+	dec.decodeBase64(v, *v, encoding, start, end)
+	return nil
+}
+
+// getBytesString reads the next JSON string and returns its bounds in the buffer, escape sequences being decoded.
+// It returns false if the value is null or is not a string, an InvalidUnmarshalError for v being set in the latter case.
+func (dec *Decoder) getBytesString(v any) (int, int, bool, error) {
+	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
+		switch dec.data[dec.cursor] {
+		case ' ', '\n', '\t', '\r', ',':
+			continue
+		case '"':
+			dec.cursor++
+			start, end, err := dec.getString()
+			if err != nil {
+				return 0, 0, false, err
+			}
+			dec.cursor = end
+			// we do minus one to remove the last quote
+			return start, end - 1, true, nil
+		case 'n':
+			dec.cursor++
+			return 0, 0, false, dec.assertNull()
+		default:
+			dec.err = dec.makeInvalidUnmarshalErr(v)
+			return 0, 0, false, dec.skipData()
+		}
+	}
+	return 0, 0, false, nil
+}
+
+// base64BufPool holds the buffers in which base64 data is decoded before being copied to a non empty slice.
+var base64BufPool = sync.Pool{
+	New: func() any {
+		buf := make([]byte, 0, 512)
+		return &buf
+	},
+}
+
+// decodeBase64 decodes the base64 data between start and end to dst, reusing its capacity.
+// On invalid data an InvalidUnmarshalError for v is set and dst is left unchanged:
+// if it is not empty, the data is decoded to a scratch buffer first, as it is only known to be valid once decoded.
+func (dec *Decoder) decodeBase64(v any, dst *[]byte, encoding *base64.Encoding, start, end int) {
+	if encoding == nil {
+		encoding = base64.StdEncoding
+	}
+	src := dec.data[start:end]
+	n := encoding.DecodedLen(len(src))
+	var err error
+	if len(*dst) == 0 {
+		buf := slices.Grow((*dst)[:0], n)[:n]
+		if n, err = encoding.Decode(buf, src); err == nil {
+			*dst = buf[:n]
+			return
+		}
+	} else {
+		//nolint:forcetypeassert
+		scratch := base64BufPool.Get().(*[]byte)
+		buf := slices.Grow((*scratch)[:0], n)[:n]
+		if n, err = encoding.Decode(buf, src); err == nil {
+			*dst = append((*dst)[:0], buf[:n]...)
+		}
+		*scratch = buf[:0]
+		base64BufPool.Put(scratch)
+		if err == nil {
+			return
+		}
+	}
+	pos := start
+	var corrupt base64.CorruptInputError
+	if errors.As(err, &corrupt) {
+		pos += int(corrupt)
+	}
+	dec.err = dec.makeDecodeErr(
+		InvalidUnmarshalError(fmt.Sprintf(invalidBase64ErrorMsg, v)),
+		pos,
+		"base64 data",
+		dec.foundChar(pos),
+	)
+}
+
+// Add Values functions
+
+// AddBytes decodes the JSON value within an object or an array to a *[]byte,
+// the JSON string is decoded with the given base64 encoding and the capacity of *v is reused.
+// If next key is not a JSON string nor null, InvalidUnmarshalError will be returned.
+func (dec *Decoder) AddBytes(v *[]byte, encoding *base64.Encoding) error {
+	return dec.Bytes(v, encoding)
+}
+
+// AddBytesNull decodes the JSON value within an object or an array to a **[]byte,
+// the JSON string is decoded with the given base64 encoding.
+// If next key is not a JSON string nor null, InvalidUnmarshalError will be returned.
+// If a `null` is encountered, gojay does not change the value of the pointer.
+func (dec *Decoder) AddBytesNull(v **[]byte, encoding *base64.Encoding) error {
+	return dec.BytesNull(v, encoding)
+}
+
+// Bytes decodes the JSON value within an object or an array to a *[]byte,
+// the JSON string is decoded with the given base64 encoding and the capacity of *v is reused.
+// If next key is not a JSON string nor null, InvalidUnmarshalError will be returned.
+// On invalid base64 data, an InvalidUnmarshalError is returned and *v is left unchanged.
+func (dec *Decoder) Bytes(v *[]byte, encoding *base64.Encoding) error {
+	err := dec.decodeBytes(v, encoding)
+	if err != nil {
+		return err
+	}
+	dec.called |= 1
+	return nil
+}
+
+// BytesNull decodes the JSON value within an object or an array to a **[]byte,
+// the JSON string is decoded with the given base64 encoding.
+// If next key is not a JSON string nor null, InvalidUnmarshalError will be returned.
+// If a `null` is encountered, gojay does not change the value of the pointer.
+func (dec *Decoder) BytesNull(v **[]byte, encoding *base64.Encoding) error {
+	err := dec.decodeBytesNull(v, encoding)
+	if err != nil {
+		return err
+	}
+	dec.called |= 1
+	return nil
+}
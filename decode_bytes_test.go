@@ -0,0 +1,162 @@
+package gojay
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testBytes struct {
+	std    []byte
+	url    []byte
+	raw    []byte
+	rawURL []byte
+	ptr    *[]byte
+}
+
+func (b *testBytes) UnmarshalJSONObject(dec *Decoder, k string) error {
+	switch k {
+	case "std":
+		return dec.Bytes(&b.std, nil)
+	case "url":
+		return dec.AddBytes(&b.url, base64.URLEncoding)
+	case "raw":
+		return dec.Bytes(&b.raw, base64.RawStdEncoding)
+	case "rawURL":
+		return dec.Bytes(&b.rawURL, base64.RawURLEncoding)
+	case "ptr":
+		return dec.AddBytesNull(&b.ptr, base64.StdEncoding)
+	}
+	return nil
+}
+
+func (b *testBytes) NKeys() int {
+	return 0
+}
+
+func TestDecodeBytes(t *testing.T) {
+	t.Parallel()
+
+	data := []byte{0xfb, 0xff, 0xfe, 'g', 'o'}
+	json := `{
+		"std": "+//+Z28=",
+		"url": "-__-Z28=",
+		"raw": "+\/\/+Z28",
+		"rawURL": "-__-Z28",
+		"ptr": "+//+Z28="
+	}`
+	var v testBytes
+	require.NoError(t, Unmarshal([]byte(json), &v))
+	assert.Equal(t, data, v.std)
+	assert.Equal(t, data, v.url)
+	assert.Equal(t, data, v.raw, "escaped slashes are decoded")
+	assert.Equal(t, data, v.rawURL)
+	require.NotNil(t, v.ptr)
+	assert.Equal(t, data, *v.ptr)
+
+	buf := make([]byte, 2, 16)
+	v = testBytes{std: buf, url: []byte("kept")}
+	require.NoError(t, Unmarshal([]byte(`{"std": "", "url": null, "ptr": null}`), &v))
+	assert.Equal(t, []byte{}, v.std)
+	assert.Same(t, &buf[:1][0], &v.std[:1][0], "the capacity is reused")
+	assert.Equal(t, []byte("kept"), v.url, "null leaves the value untouched")
+	assert.Nil(t, v.ptr)
+
+	dec := BorrowDecoder(strings.NewReader(`"aGVsbG8="`))
+	defer dec.Release()
+	var b []byte
+	require.NoError(t, dec.DecodeBytes(&b, nil))
+	assert.Equal(t, []byte("hello"), b)
+}
+
+func TestDecodeBytesErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		json     string
+		offset   int
+		path     string
+		expected string
+		found    string
+	}{
+		{
+			name:     "invalid char",
+			json:     `{"std": "Z2*v"}`,
+			offset:   11,
+			path:     "$.std",
+			expected: "base64 data",
+			found:    "'*'",
+		},
+		{
+			name:     "invalid char after valid data",
+			json:     `{"std": "aGVsbG8gd29y*GQ="}`,
+			offset:   21,
+			path:     "$.std",
+			expected: "base64 data",
+			found:    "'*'",
+		},
+		{
+			name:     "url alphabet with the standard encoding",
+			json:     `{"std": "-__-Z28="}`,
+			offset:   9,
+			path:     "$.std",
+			expected: "base64 data",
+			found:    "'-'",
+		},
+		{
+			name:     "missing padding",
+			json:     `{"url": "Z28"}`,
+			offset:   9,
+			path:     "$.url",
+			expected: "base64 data",
+			found:    "'Z'",
+		},
+		{
+			name:     "not a string",
+			json:     `{"raw": [1, 2]}`,
+			offset:   8,
+			path:     "$.raw",
+			expected: "*[]uint8",
+			found:    "array",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			kept := []byte("kept bytes, not to be overwritten")
+			v := testBytes{std: kept, url: kept[:4:4]}
+			err := Unmarshal([]byte(testCase.json), &v)
+			// the slices are left unchanged, including their backing array
+			assert.Equal(t, []byte("kept bytes, not to be overwritten"), kept)
+			assert.Equal(t, []byte("kept"), v.url)
+			var decErr *DecodeError
+			require.ErrorAs(t, err, &decErr)
+			assert.IsType(t, InvalidUnmarshalError(""), decErr.Err)
+			assert.Equal(t, testCase.offset, decErr.Offset)
+			assert.Equal(t, testCase.path, decErr.Path)
+			assert.Equal(t, testCase.expected, decErr.Expected)
+			assert.Equal(t, testCase.found, decErr.Found)
+		})
+	}
+}
+
+func TestDecodeBytesNoAlloc(t *testing.T) {
+	json := []byte(`{"std": "+//+Z28="}`)
+	data := make([]byte, len(json))
+	v := testBytes{std: make([]byte, 0, 16)}
+	dec := NewDecoder(nil)
+	allocs := testing.AllocsPerRun(100, func() {
+		copy(data, json)
+		dec.data = data
+		dec.length = len(data)
+		dec.cursor = 0
+		_ = dec.DecodeObject(&v)
+	})
+	assert.Zero(t, allocs)
+	assert.Equal(t, []byte{0xfb, 0xff, 0xfe, 'g', 'o'}, v.std)
+}
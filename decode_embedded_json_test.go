@@ -179,7 +179,7 @@ func TestDecodeEmbeddedJSONNil(t *testing.T) {
 	var ej *EmbeddedJSON
 	err := dec.decodeEmbeddedJSON(ej)
 	require.Error(t, err, `err should not be nil a nil pointer is given`)
-	assert.IsType(t, InvalidUnmarshalError(""), err, `err should not be of type InvalidUnmarshalError`)
+	assert.ErrorAs(t, err, new(InvalidUnmarshalError), `err should not be of type InvalidUnmarshalError`)
 }
 
 func TestDecodeEmbeddedJSONNil2(t *testing.T) {
@@ -189,5 +189,5 @@ func TestDecodeEmbeddedJSONNil2(t *testing.T) {
 	var ej *EmbeddedJSON
 	err := dec.AddEmbeddedJSON(ej)
 	require.Error(t, err, `err should not be nil a nil pointer is given`)
-	assert.IsType(t, InvalidUnmarshalError(""), err, `err should not be of type InvalidUnmarshalError`)
+	assert.ErrorAs(t, err, new(InvalidUnmarshalError), `err should not be of type InvalidUnmarshalError`)
 }
@@ -1,113 +1,211 @@
 package gojay
 
-// TODO for now use the standard json unmarshalling but in the future it would be great to implement one here.
-import "encoding/json"
+import (
+	"encoding/json"
+	"strconv"
+	"unsafe"
+)
 
 // DecodeInterface reads the next JSON-encoded value from the decoder's input (io.Reader) and stores it in the value pointed to by i.
 //
 // i must be an interface pointer.
+//
+// JSON objects are decoded to map[string]any, arrays to []any, strings to string, booleans to bool
+// and numbers to float64, or json.Number if UseNumber was called on the Decoder.
+// If the value is `null`, i is left untouched.
 func (dec *Decoder) DecodeInterface(i *any) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	err := dec.decodeInterface(i)
 	return err
 }
 
-func (dec *Decoder) decodeInterface(i *any) error {
-	start, end, err := dec.getObject()
-	if err != nil {
-		dec.cursor = start
-		return err
-	}
-
-	// if start & end are equal the object is a null, don't unmarshal
-	if start == end {
-		return nil
-	}
-
-	object := dec.data[start:end]
-	if err = json.Unmarshal(object, i); err != nil {
-		return err
-	}
-
-	dec.cursor = end
-	return nil
+// UseNumber causes the Decoder to decode a number to a json.Number instead of a float64
+// when decoding to an any.
+func (dec *Decoder) UseNumber() {
+	dec.useNumber = true
 }
 
-//nolint:funlen,nonamedreturns
-func (dec *Decoder) getObject() (start int, end int, err error) {
-	// start cursor
+func (dec *Decoder) decodeInterface(i *any) error {
 	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
 		switch dec.data[dec.cursor] {
 		case ' ', '\n', '\t', '\r', ',':
 			continue
-		// is null
+		// is null, leave the value untouched
 		case 'n':
 			dec.cursor++
-			err = dec.assertNull()
+			return dec.assertNull()
+		default:
+			// getInterface leaves the path and nesting depth as is on error
+			depth := len(dec.path)
+			nesting := dec.depth
+			v, err := dec.getInterface()
+			dec.path = dec.path[:depth]
+			dec.depth = nesting
 			if err != nil {
-				return
+				return err
 			}
-			// Set start & end to the same cursor to indicate the object
-			// is a null and should not be unmarshal
-			start = dec.cursor
-			end = dec.cursor
-			return
-		case 't':
-			start = dec.cursor
-			dec.cursor++
-			err = dec.assertTrue()
-			if err != nil {
-				return
+			*i = v
+			return nil
+		}
+	}
+	return dec.raiseInvalidJSONErr(dec.cursor)
+}
+
+// getInterface decodes the value starting at the cursor,
+// cursor is placed right after the value.
+//
+//nolint:cyclop
+func (dec *Decoder) getInterface() (any, error) {
+	switch dec.data[dec.cursor] {
+	case '{':
+		dec.depth++
+		if err := dec.checkDepth(dec.depth, dec.cursor); err != nil {
+			return nil, err
+		}
+		dec.cursor++
+		return dec.getInterfaceObject()
+	case '[':
+		dec.depth++
+		if err := dec.checkDepth(dec.depth, dec.cursor); err != nil {
+			return nil, err
+		}
+		dec.cursor++
+		return dec.getInterfaceArray()
+	case '"':
+		dec.cursor++
+		start, end, err := dec.getString()
+		if err != nil {
+			return nil, err
+		}
+		// we do minus one to remove the last quote
+		d := dec.data[start : end-1]
+		return *(*string)(unsafe.Pointer(&d)), nil
+	case 't':
+		dec.cursor++
+		if err := dec.assertTrue(); err != nil {
+			return nil, err
+		}
+		return true, nil
+	case 'f':
+		dec.cursor++
+		if err := dec.assertFalse(); err != nil {
+			return nil, err
+		}
+		return false, nil
+	case 'n':
+		dec.cursor++
+		if err := dec.assertNull(); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		start, end, err := dec.getNumber()
+		if err != nil {
+			return nil, err
+		}
+		if dec.useNumber {
+			return json.Number(dec.data[start:end]), nil
+		}
+		d := dec.data[start:end]
+		f, err := strconv.ParseFloat(*(*string)(unsafe.Pointer(&d)), 64)
+		if err != nil {
+			return nil, dec.makeInvalidUnmarshalErr(f)
+		}
+		return f, nil
+	default:
+		return nil, dec.raiseUnexpectedErr(dec.cursor, "value")
+	}
+}
+
+func (dec *Decoder) getInterfaceObject() (map[string]any, error) {
+	m := make(map[string]any)
+	if dec.skipSpaces() == '}' {
+		dec.cursor++
+		dec.depth--
+		return m, nil
+	}
+	depth := len(dec.path)
+	for {
+		if dec.skipSpaces() != '"' {
+			return nil, dec.raiseUnexpectedErr(dec.cursor, "string key")
+		}
+		dec.cursor++
+		start, end, err := dec.getString()
+		if err != nil {
+			return nil, err
+		}
+		d := dec.data[start : end-1]
+		k := *(*string)(unsafe.Pointer(&d))
+		dec.setKeyPath(depth, start, end-1)
+		if dec.skipSpaces() != ':' {
+			return nil, dec.raiseUnexpectedErr(dec.cursor, "':'")
+		}
+		dec.cursor++
+		if dec.skipSpaces() == 0 {
+			return nil, dec.raiseUnexpectedErr(dec.cursor, "value")
+		}
+		if _, ok := m[k]; ok && dec.duplicateKeys != DuplicateKeyLastWins {
+			if err := dec.duplicateKeyErr(k); err != nil {
+				return nil, err
 			}
-			end = dec.cursor
-			dec.cursor++
-			return
-		// is false
-		case 'f':
-			start = dec.cursor
-			dec.cursor++
-			err = dec.assertFalse()
+			// the first value wins, the duplicate is still parsed to validate it
+			if _, err := dec.getInterface(); err != nil {
+				return nil, err
+			}
+		} else {
+			v, err := dec.getInterface()
 			if err != nil {
-				return
+				return nil, err
 			}
-			end = dec.cursor
+			m[k] = v
+		}
+		switch dec.skipSpaces() {
+		case ',':
 			dec.cursor++
-			return
-		// is an object
-		case '{':
-			start = dec.cursor
+		case '}':
 			dec.cursor++
-			end, err = dec.skipObject()
-			dec.cursor = end
-			return
-		// is string
-		case '"':
+			dec.path = dec.path[:depth]
+			dec.depth--
+			return m, nil
+		default:
+			return nil, dec.raiseUnexpectedErr(dec.cursor, "',' or '}'")
+		}
+	}
+}
+
+func (dec *Decoder) getInterfaceArray() ([]any, error) {
+	arr := make([]any, 0)
+	if dec.skipSpaces() == ']' {
+		dec.cursor++
+		dec.depth--
+		return arr, nil
+	}
+	depth := len(dec.path)
+	for {
+		dec.setPath(depth, pathElem{index: len(arr)})
+		if dec.skipSpaces() == 0 {
+			return nil, dec.raiseUnexpectedErr(dec.cursor, "value")
+		}
+		v, err := dec.getInterface()
+		if err != nil {
+			return nil, err
+		}
+		arr = append(arr, v)
+		switch dec.skipSpaces() {
+		case ',':
 			dec.cursor++
-			start, end, err = dec.getString()
-			start--
-			dec.cursor = end
-			return
-		// is array
-		case '[':
-			start = dec.cursor
+		case ']':
 			dec.cursor++
-			end, err = dec.skipArray()
-			dec.cursor = end
-			return
-		case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9', '-':
-			start = dec.cursor
-			end, err = dec.skipNumber()
-			dec.cursor = end
-			return
+			dec.path = dec.path[:depth]
+			dec.depth--
+			return arr, nil
 		default:
-			err = dec.raiseInvalidJSONErr(dec.cursor)
-			return
+			return nil, dec.raiseUnexpectedErr(dec.cursor, "',' or ']'")
 		}
 	}
-	err = dec.raiseInvalidJSONErr(dec.cursor)
-	return
 }
 
 // Add Values functions
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -66,28 +67,28 @@ func TestDecodeInterfaceBasic(t *testing.T) {
 			name:            "array-error",
 			json:            `["h""o","l","a"]`,
 			err:             true,
-			errType:         &json.SyntaxError{},
+			errType:         new(InvalidJSONError),
 			skipCheckResult: true,
 		},
 		{
 			name:            "object-error",
 			json:            `{"testStr" "hello world!"}`,
 			err:             true,
-			errType:         &json.SyntaxError{},
+			errType:         new(InvalidJSONError),
 			skipCheckResult: true,
 		},
 		{
 			name:            "string-error",
 			json:            `"hola amigos!`,
 			err:             true,
-			errType:         InvalidJSONError(""),
+			errType:         new(InvalidJSONError),
 			skipCheckResult: true,
 		},
 		{
 			name:            "bool-true-error",
 			json:            `truee`,
 			err:             true,
-			errType:         InvalidJSONError(""),
+			errType:         new(InvalidJSONError),
 			skipCheckResult: true,
 		},
 		{
@@ -95,35 +96,35 @@ func TestDecodeInterfaceBasic(t *testing.T) {
 			json:            `fase`,
 			expectedResult:  any(false),
 			err:             true,
-			errType:         InvalidJSONError(""),
+			errType:         new(InvalidJSONError),
 			skipCheckResult: true,
 		},
 		{
 			name:            "null-error",
 			json:            `nulllll`,
 			err:             true,
-			errType:         InvalidJSONError(""),
+			errType:         new(InvalidJSONError),
 			skipCheckResult: true,
 		},
 		{
 			name:            "number-error",
 			json:            `1234"`,
 			err:             true,
-			errType:         InvalidJSONError(""),
+			errType:         new(InvalidJSONError),
 			skipCheckResult: true,
 		},
 		{
 			name:            "unknown-error",
 			json:            `?`,
 			err:             true,
-			errType:         InvalidJSONError(""),
+			errType:         new(InvalidJSONError),
 			skipCheckResult: true,
 		},
 		{
 			name:            "empty-json-error",
 			json:            ``,
 			err:             true,
-			errType:         InvalidJSONError(""),
+			errType:         new(InvalidJSONError),
 			skipCheckResult: true,
 		},
 	}
@@ -138,7 +139,7 @@ func TestDecodeInterfaceBasic(t *testing.T) {
 				t.Log(err)
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(t, testCase.errType, err, "err should be of the given type")
+					assert.ErrorAs(t, err, testCase.errType, "err should be of the given type")
 				}
 				return
 			}
@@ -161,7 +162,7 @@ func TestDecodeInterfaceBasic(t *testing.T) {
 				t.Log(err)
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(t, testCase.errType, err, "err should be of the given type")
+					assert.ErrorAs(t, err, testCase.errType, "err should be of the given type")
 				}
 				return
 			}
@@ -212,7 +213,7 @@ func TestDecodeInterfaceAsInterface(t *testing.T) {
         "testInterface": ["a""d","i","o","s"]
       }`,
 			err:             true,
-			errType:         &json.SyntaxError{},
+			errType:         new(InvalidJSONError),
 			skipCheckResult: true,
 		},
 		{
@@ -273,7 +274,7 @@ func TestDecodeInterfaceAsInterface(t *testing.T) {
 				t.Log(err)
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(t, testCase.errType, err, "err should be of the given type")
+					assert.ErrorAs(t, err, testCase.errType, "err should be of the given type")
 				}
 				return
 			}
@@ -296,7 +297,7 @@ func TestDecodeInterfaceAsInterface(t *testing.T) {
 				t.Log(err)
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(t, testCase.errType, err, "err should be of the given type")
+					assert.ErrorAs(t, err, testCase.errType, "err should be of the given type")
 				}
 				return
 			}
@@ -347,7 +348,7 @@ func TestDecodeAsTestObject(t *testing.T) {
         "testInterface": ["a""d","i","o","s"]
       }`,
 			err:             true,
-			errType:         &json.SyntaxError{},
+			errType:         new(InvalidJSONError),
 			skipCheckResult: true,
 		},
 		{
@@ -421,7 +422,7 @@ func TestDecodeAsTestObject(t *testing.T) {
 				t.Log(err)
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(t, testCase.errType, err, "err should be of the given type")
+					assert.ErrorAs(t, err, testCase.errType, "err should be of the given type")
 				}
 				return
 			}
@@ -518,7 +519,7 @@ func TestUnmarshalInterfaceError(t *testing.T) {
 			err := Unmarshal(testCase.json, v)
 			require.Error(t, err)
 			t.Log(err)
-			assert.IsType(t, &json.SyntaxError{}, err, "err should be a json.SyntaxError{}")
+			assert.ErrorAs(t, err, new(InvalidJSONError), "err should be an InvalidJSONError")
 		})
 	}
 }
@@ -548,3 +549,141 @@ func TestDecodeNull(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, any(nil), i, "value at given index should be the same as expected results")
 }
+
+func TestDecodeInterfaceNative(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		json           string
+		useNumber      bool
+		expectedResult any
+		err            bool
+	}{
+		{
+			name:           "empty-object",
+			json:           ` { } `,
+			expectedResult: map[string]any{},
+		},
+		{
+			name:           "empty-array",
+			json:           `[ ]`,
+			expectedResult: []any{},
+		},
+		{
+			name:           "escaped-key-and-value",
+			json:           `{"k\"eéy":"v\nalé"}`,
+			expectedResult: map[string]any{"k\"eéy": "v\nalé"},
+		},
+		{
+			name: "nested",
+			json: "{\"a\":[1,-2.5,3e2,{\"b\":[true,false,null]}],\r\n\"c\":{}}",
+			expectedResult: map[string]any{
+				"a": []any{float64(1), -2.5, float64(300), map[string]any{"b": []any{true, false, nil}}},
+				"c": map[string]any{},
+			},
+		},
+		{
+			name:           "precise-float",
+			json:           `[0.1,1.7976931348623157e308,-0]`,
+			expectedResult: []any{0.1, 1.7976931348623157e308, float64(0)},
+		},
+		{
+			name:           "use-number",
+			json:           `{"n":12345678901234567890,"f":-1.5E-3}`,
+			useNumber:      true,
+			expectedResult: map[string]any{"n": json.Number("12345678901234567890"), "f": json.Number("-1.5E-3")},
+		},
+		{
+			name: "number-overflow",
+			json: `[1e400]`,
+			err:  true,
+		},
+		{
+			name: "leading-zero",
+			json: `[01]`,
+			err:  true,
+		},
+		{
+			name: "missing-fraction",
+			json: `[1.]`,
+			err:  true,
+		},
+		{
+			name: "missing-exponent",
+			json: `[1e+]`,
+			err:  true,
+		},
+		{
+			name: "trailing-comma-array",
+			json: `[1,]`,
+			err:  true,
+		},
+		{
+			name: "missing-comma-object",
+			json: `{"a":1 "b":2}`,
+			err:  true,
+		},
+		{
+			name: "missing-colon",
+			json: `{"a" 1}`,
+			err:  true,
+		},
+		{
+			name: "non-string-key",
+			json: `{1:1}`,
+			err:  true,
+		},
+		{
+			name: "unterminated-object",
+			json: `{"a":1`,
+			err:  true,
+		},
+		{
+			name: "unterminated-array",
+			json: `[1,[2`,
+			err:  true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var i any
+			dec := NewDecoder(strings.NewReader(testCase.json))
+			if testCase.useNumber {
+				dec.UseNumber()
+			}
+			err := dec.DecodeInterface(&i)
+			if testCase.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedResult, i)
+		})
+	}
+}
+
+func TestDecodeInterfaceSmallReads(t *testing.T) {
+	t.Parallel()
+
+	var i any
+	dec := BorrowDecoder(iotest.OneByteReader(strings.NewReader(`{"key":["value",1.25,{"k":"v"}],"other":"` + strings.Repeat("x", 1000) + `"}`)))
+	defer dec.Release()
+	err := dec.DecodeInterface(&i)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"key":   []any{"value", 1.25, map[string]any{"k": "v"}},
+		"other": strings.Repeat("x", 1000),
+	}, i)
+}
+
+func TestDecodeInterfaceNullUntouched(t *testing.T) {
+	t.Parallel()
+
+	i := any("untouched")
+	err := Unmarshal([]byte(`null`), &i)
+	require.NoError(t, err)
+	assert.Equal(t, "untouched", i)
+}
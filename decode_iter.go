@@ -0,0 +1,177 @@
+package gojay
+
+import (
+	"fmt"
+	"iter"
+)
+
+const invalidIterErrorMsg = "Cannot iterate over JSON %s as an %s"
+
+// ObjectIter returns an iterator over the keys of the next JSON object of the input,
+// yielding each key with the Decoder positioned at its value.
+// The loop body decodes the value with a method of the Decoder, like dec.String, dec.Object or a nested ObjectIter,
+// the value being skipped if it doesn't. Breaking out of the loop skips the rest of the object.
+//
+// Iteration stops at the first error, which is returned by Err after the loop.
+// A null yields no key, any other value than an object is an InvalidUnmarshalError.
+// Like for UnmarshalJSONObject the key is unescaped, it points to the buffer of the Decoder.
+//
+//	for k, dec := range dec.ObjectIter() {
+//		if k == "id" {
+//			dec.Int(&id)
+//		}
+//	}
+//	if err := dec.Err(); err != nil {
+//		return err
+//	}
+func (dec *Decoder) ObjectIter() iter.Seq2[string, *Decoder] {
+	return func(yield func(string, *Decoder) bool) {
+		depth := len(dec.path)
+		nesting := dec.depth
+		defer func() {
+			dec.path = dec.path[:depth]
+			dec.depth = nesting
+		}()
+		if !dec.iterStart('{', "object") {
+			return
+		}
+		var seen map[string]struct{}
+		for {
+			dec.discardRead()
+			k, done, err := dec.nextKey(depth)
+			if err != nil {
+				dec.err = err
+				return
+			} else if done {
+				return
+			}
+			if dec.duplicateKeys != DuplicateKeyLastWins {
+				if skipped, err := dec.skipDuplicateKey(&seen, k); err != nil {
+					return
+				} else if skipped {
+					continue
+				}
+			}
+			dec.nextChar()
+			start := dec.cursor
+			if !yield(k, dec) {
+				dec.iterBreak(dec.skipObject)
+				return
+			}
+			if dec.err != nil {
+				return
+			}
+			if dec.cursor == start && dec.skipUnknownKey(k) != nil {
+				return
+			}
+		}
+	}
+}
+
+// ArrayIter returns an iterator over the elements of the next JSON array of the input,
+// yielding the Decoder positioned at each element.
+// The loop body decodes the element with a method of the Decoder, like dec.String, dec.Object or a nested ArrayIter,
+// the element being skipped if it doesn't. Breaking out of the loop skips the rest of the array.
+//
+// Iteration stops at the first error, which is returned by Err after the loop.
+// A null yields no element, any other value than an array is an InvalidUnmarshalError.
+//
+//	for dec := range dec.ArrayIter() {
+//		var s string
+//		dec.String(&s)
+//		strs = append(strs, s)
+//	}
+//	if err := dec.Err(); err != nil {
+//		return err
+//	}
+func (dec *Decoder) ArrayIter() iter.Seq[*Decoder] {
+	return func(yield func(*Decoder) bool) {
+		depth := len(dec.path)
+		nesting := dec.depth
+		defer func() {
+			dec.path = dec.path[:depth]
+			dec.depth = nesting
+		}()
+		if !dec.iterStart('[', "array") {
+			return
+		}
+		for i := 0; ; i++ {
+			dec.discardRead()
+			switch dec.nextChar() {
+			case ']':
+				dec.cursor++
+				return
+			case 0, '}':
+				_ = dec.raiseUnexpectedErr(dec.cursor, "value or ']'")
+				return
+			}
+			dec.setPath(depth, pathElem{index: i})
+			start := dec.cursor
+			if !yield(dec) {
+				dec.iterBreak(dec.skipArray)
+				return
+			}
+			if dec.err != nil {
+				return
+			}
+			if dec.cursor == start && dec.skipData() != nil {
+				return
+			}
+		}
+	}
+}
+
+// Err returns the first error met by the Decoder, like an error stopping the iteration of ObjectIter or ArrayIter.
+func (dec *Decoder) Err() error {
+	return dec.err
+}
+
+// iterStart reads the start of the object or array to iterate over, c being '{' or '['.
+// It returns false if there is nothing to iterate over, the value being null or an error being set.
+func (dec *Decoder) iterStart(c byte, kind string) bool {
+	if dec.isPooled == 1 {
+		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
+	}
+	if dec.err != nil {
+		return false
+	}
+	dec.compact()
+	switch dec.nextChar() {
+	case c:
+		dec.depth++
+		if err := dec.checkDepth(dec.depth, dec.cursor); err != nil {
+			return false
+		}
+		dec.cursor++
+		return true
+	case 'n':
+		dec.cursor++
+		_ = dec.assertNull()
+		return false
+	case 0:
+		_ = dec.raiseUnexpectedErr(dec.cursor, "value")
+		return false
+	default:
+		found := dec.foundKind(dec.cursor)
+		dec.err = dec.makeDecodeErr(
+			InvalidUnmarshalError(fmt.Sprintf(invalidIterErrorMsg, found, kind)),
+			dec.cursor,
+			kind,
+			found,
+		)
+		_ = dec.skipData()
+		return false
+	}
+}
+
+// iterBreak skips the rest of the object or array being iterated over with skip, skipObject or skipArray,
+// the loop body having stopped the iteration.
+func (dec *Decoder) iterBreak(skip func() (int, error)) {
+	if dec.err != nil {
+		return
+	}
+	dec.depth--
+	if end, err := skip(); err == nil {
+		dec.cursor = end
+	}
+}
@@ -0,0 +1,176 @@
+package gojay
+
+import (
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecoderObjectIter(t *testing.T) {
+	t.Parallel()
+
+	const input = `{"id": 1, "skipped": {"a": [1, "}"]}, "tags": ["a", "b"], "user": {"id": 2, "name": "b"}, "n": null}`
+	dec := NewDecoder(iotest.OneByteReader(strings.NewReader(input)))
+	var keys []string
+	var id int
+	var tags []string
+	u := &testSliceUser{}
+	for k, dec := range dec.ObjectIter() {
+		keys = append(keys, k)
+		switch k {
+		case "id":
+			require.NoError(t, dec.Int(&id))
+		case "tags":
+			for dec := range dec.ArrayIter() {
+				var s string
+				require.NoError(t, dec.String(&s))
+				tags = append(tags, s)
+			}
+		case "user":
+			require.NoError(t, dec.Object(u))
+		}
+	}
+	require.NoError(t, dec.Err())
+	assert.Equal(t, []string{"id", "skipped", "tags", "user", "n"}, keys)
+	assert.Equal(t, 1, id)
+	assert.Equal(t, []string{"a", "b"}, tags)
+	assert.Equal(t, &testSliceUser{id: 2, name: "b"}, u)
+}
+
+func TestDecoderArrayIter(t *testing.T) {
+	t.Parallel()
+
+	dec := BorrowDecoder(strings.NewReader(`[[1, 2], [], [3], null]`))
+	defer dec.Release()
+	var sums []int
+	for dec := range dec.ArrayIter() {
+		sum := 0
+		for dec := range dec.ArrayIter() {
+			var v int
+			require.NoError(t, dec.Int(&v))
+			sum += v
+		}
+		sums = append(sums, sum)
+	}
+	require.NoError(t, dec.Err())
+	assert.Equal(t, []int{3, 0, 3, 0}, sums)
+
+	dec = BorrowDecoder(strings.NewReader(`[1, 2, 3] [4, 5, 6] null`))
+	defer dec.Release()
+	var v []int
+	for dec := range dec.ArrayIter() {
+		var i int
+		require.NoError(t, dec.Int(&i))
+		v = append(v, i)
+		if i == 2 {
+			// the rest of the array is skipped
+			break
+		}
+	}
+	for dec := range dec.ArrayIter() {
+		var i int
+		require.NoError(t, dec.Int(&i))
+		v = append(v, i)
+	}
+	for range dec.ArrayIter() {
+		t.Fatal("null has no element")
+	}
+	require.NoError(t, dec.Err())
+	assert.Equal(t, []int{1, 2, 4, 5, 6}, v)
+}
+
+func TestDecoderIterErrors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid-json", func(t *testing.T) {
+		t.Parallel()
+
+		dec := NewDecoder(strings.NewReader(`{"a": [1, 2}`))
+		var n int
+		for _, dec := range dec.ObjectIter() {
+			for range dec.ArrayIter() {
+				n++
+			}
+		}
+		var decErr *DecodeError
+		require.ErrorAs(t, dec.Err(), &decErr)
+		assert.IsType(t, InvalidJSONError(""), decErr.Err)
+		assert.Equal(t, 11, decErr.Offset)
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("not-an-object", func(t *testing.T) {
+		t.Parallel()
+
+		dec := NewDecoder(strings.NewReader(`{"a": "x"}`))
+		for _, dec := range dec.ObjectIter() {
+			for range dec.ObjectIter() {
+				t.Fatal("a string has no key")
+			}
+		}
+		var decErr *DecodeError
+		require.ErrorAs(t, dec.Err(), &decErr)
+		assert.Equal(t, InvalidUnmarshalError("Cannot iterate over JSON string as an object"), decErr.Err)
+		assert.Equal(t, "$.a", decErr.Path)
+	})
+
+	t.Run("path-of-discarded-keys", func(t *testing.T) {
+		t.Parallel()
+
+		// the keys of the path are dropped from the buffer between two keys
+		dec := NewDecoder(iotest.OneByteReader(strings.NewReader(`{"a": {"b": 1, "c d": {"e": "x"}}}`)))
+		for _, dec := range dec.ObjectIter() {
+			for k, dec := range dec.ObjectIter() {
+				if k == "b" {
+					var i int
+					require.NoError(t, dec.Int(&i))
+					continue
+				}
+				for _, dec := range dec.ObjectIter() {
+					var i int
+					_ = dec.Int(&i)
+				}
+			}
+		}
+		var decErr *DecodeError
+		require.ErrorAs(t, dec.Err(), &decErr)
+		assert.Equal(t, `$.a["c d"].e`, decErr.Path)
+	})
+
+	t.Run("stops-at-first-error", func(t *testing.T) {
+		t.Parallel()
+
+		dec := NewDecoder(strings.NewReader(`[1, "x", 3]`))
+		var v []int
+		for dec := range dec.ArrayIter() {
+			var i int
+			_ = dec.Int(&i)
+			v = append(v, i)
+		}
+		var decErr *DecodeError
+		require.ErrorAs(t, dec.Err(), &decErr)
+		assert.Equal(t, "$[1]", decErr.Path)
+		assert.Equal(t, []int{1, 0}, v)
+	})
+
+	t.Run("strict", func(t *testing.T) {
+		t.Parallel()
+
+		dec := NewDecoder(strings.NewReader(`{"a": 1, "b": 2}`), WithStrict())
+		var keys []string
+		for k, dec := range dec.ObjectIter() {
+			keys = append(keys, k)
+			if k == "a" {
+				var i int
+				require.NoError(t, dec.Int(&i))
+			}
+		}
+		var unknown *UnknownKeyError
+		require.ErrorAs(t, dec.Err(), &unknown)
+		assert.Equal(t, "b", unknown.Key)
+		assert.Equal(t, []string{"a", "b"}, keys)
+	})
+}
@@ -0,0 +1,71 @@
+package gojay
+
+import "strings"
+
+// DecodeMap decodes the next JSON object to the map pointed to by m, decoding each value with decodeValue.
+// Method expressions of the Decoder can be used as decodeValue, like (*Decoder).String or (*Decoder).Int64.
+//
+// A nil map is allocated if the value is an object, a `null` leaves the map untouched.
+// Keys are unescaped, a map encoded by EncodeMap is decoded with the same keys.
+// Decoded entries are added to the map, existing entries are kept.
+//
+// Example:
+//
+//	labels := map[string]string{}
+//	err := gojay.DecodeMap(dec, &labels, (*gojay.Decoder).String)
+func DecodeMap[K ~string, V any](dec *Decoder, m *map[K]V, decodeValue func(dec *Decoder, v *V) error) error {
+	switch dec.nextChar() {
+	case '{':
+		if *m == nil {
+			*m = make(map[K]V)
+		}
+	case 'n', 0:
+	default:
+		// the error reports the type of the map rather than the one of the object func
+		return dec.skipInvalidUnmarshal(m)
+	}
+	return dec.Object(DecodeObjectFunc(func(dec *Decoder, k string) error {
+		var v V
+		if err := decodeValue(dec, &v); err != nil {
+			return err
+		}
+		if dec.reuseBuffer {
+			// the key points to the buffer which is overwritten by the next top level value
+			k = strings.Clone(k)
+		}
+		(*m)[K(k)] = v
+		return nil
+	}))
+}
+
+// AddMapStringString unmarshals the next JSON object of strings to the given *map[string]string m.
+func (dec *Decoder) AddMapStringString(m *map[string]string) error {
+	return dec.MapStringString(m)
+}
+
+// MapStringString unmarshals the next JSON object of strings to the given *map[string]string m.
+func (dec *Decoder) MapStringString(m *map[string]string) error {
+	return DecodeMap(dec, m, (*Decoder).String)
+}
+
+// AddMapStringInt unmarshals the next JSON object of integers to the given *map[string]int m.
+func (dec *Decoder) AddMapStringInt(m *map[string]int) error {
+	return dec.MapStringInt(m)
+}
+
+// MapStringInt unmarshals the next JSON object of integers to the given *map[string]int m.
+func (dec *Decoder) MapStringInt(m *map[string]int) error {
+	return DecodeMap(dec, m, (*Decoder).Int)
+}
+
+// AddMapStringAny unmarshals the next JSON object to the given *map[string]any m,
+// values are decoded like with Interface.
+func (dec *Decoder) AddMapStringAny(m *map[string]any) error {
+	return dec.MapStringAny(m)
+}
+
+// MapStringAny unmarshals the next JSON object to the given *map[string]any m,
+// values are decoded like with Interface.
+func (dec *Decoder) MapStringAny(m *map[string]any) error {
+	return DecodeMap(dec, m, (*Decoder).Interface)
+}
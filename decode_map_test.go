@@ -0,0 +1,114 @@
+package gojay
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testLabel string
+
+type testMaps struct {
+	labels  map[string]string
+	counts  map[string]int
+	extra   map[string]any
+	typed   map[testLabel][]string
+	missing map[string]string
+}
+
+func (t *testMaps) UnmarshalJSONObject(dec *Decoder, k string) error {
+	switch k {
+	case "labels":
+		return dec.MapStringString(&t.labels)
+	case "counts":
+		return dec.AddMapStringInt(&t.counts)
+	case "extra":
+		return dec.MapStringAny(&t.extra)
+	case "typed":
+		return DecodeMap(dec, &t.typed, (*Decoder).SliceString)
+	case "missing":
+		return dec.AddMapStringString(&t.missing)
+	}
+	return nil
+}
+
+func (t *testMaps) NKeys() int {
+	return 0
+}
+
+func TestDecodeMap(t *testing.T) {
+	t.Parallel()
+
+	v := &testMaps{counts: map[string]int{"kept": 1}}
+	json := `{
+		"labels": {"env": "prod", "team": "core"},
+		"counts": {"a": 1, "b": -2},
+		"extra": {"n": 1.5, "list": [true, null], "obj": {}},
+		"typed": {"x": ["a", "b"], "y": []},
+		"missing": null
+	}`
+	require.NoError(t, Unmarshal([]byte(json), v))
+	assert.Equal(t, map[string]string{"env": "prod", "team": "core"}, v.labels)
+	assert.Equal(t, map[string]int{"kept": 1, "a": 1, "b": -2}, v.counts, "existing entries are kept")
+	assert.Equal(t, map[string]any{"n": 1.5, "list": []any{true, nil}, "obj": map[string]any{}}, v.extra)
+	assert.Equal(t, map[testLabel][]string{"x": {"a", "b"}, "y": nil}, v.typed)
+	assert.Nil(t, v.missing, "null leaves the map untouched")
+
+	v = &testMaps{}
+	require.NoError(t, Unmarshal([]byte(`{"labels":{}}`), v))
+	assert.NotNil(t, v.labels, "an empty object allocates the map")
+	assert.Empty(t, v.labels)
+}
+
+func TestDecodeMapEscapedKeys(t *testing.T) {
+	t.Parallel()
+
+	m := map[string]string{"a\"b": "1", "a\nb": "2", "é\\/": "3", "\u2028<": "4"}
+	b, err := Marshal(EncodeMap(m, (*Encoder).StringKey).Sorted())
+	require.NoError(t, err)
+	var got map[string]string
+	dec := BorrowDecoder(strings.NewReader(string(b)))
+	defer dec.Release()
+	require.NoError(t, DecodeMap(dec, &got, (*Decoder).String))
+	assert.Equal(t, m, got, "keys are unescaped")
+
+	v := &testMaps{}
+	require.NoError(t, Unmarshal([]byte(`{"labels": {"\u0061\tb": "x"}}`), v))
+	assert.Equal(t, map[string]string{"a\tb": "x"}, v.labels)
+}
+
+func TestDecodeMapTopLevel(t *testing.T) {
+	t.Parallel()
+
+	dec := NewDecoder(strings.NewReader(`{"a":"1"} {"b":"2"}`), WithBufferReuse())
+	defer dec.Release()
+	var m map[string]string
+	require.NoError(t, DecodeMap(dec, &m, (*Decoder).String))
+	require.NoError(t, DecodeMap(dec, &m, (*Decoder).StringNoEscape))
+	assert.Equal(t, map[string]string{"a": "1", "b": "2"}, m, "keys are copied when the buffer is reused")
+}
+
+func TestDecodeMapErrors(t *testing.T) {
+	t.Parallel()
+
+	err := Unmarshal([]byte(`{"labels":["a"],"counts":{"a":1}}`), &testMaps{})
+	var decErr *DecodeError
+	require.ErrorAs(t, err, &decErr)
+	assert.ErrorAs(t, err, new(InvalidUnmarshalError))
+	assert.Equal(t, "*map[string]string", decErr.Expected)
+	assert.Equal(t, "array", decErr.Found)
+
+	v := &testMaps{}
+	err = Unmarshal([]byte(`{"counts":{"a":"1","b":2}}`), v)
+	require.ErrorAs(t, err, &decErr)
+	assert.Equal(t, "$.counts.a", decErr.Path)
+	assert.Equal(t, map[string]int{"a": 0}, v.counts, "decoding stops at the error")
+
+	err = Unmarshal([]byte(`{"counts":{"a":1,"a":2}}`), &testMaps{}, WithDuplicateKeyPolicy(DuplicateKeyFails))
+	require.ErrorAs(t, err, new(*DuplicateKeyError))
+
+	err = Unmarshal([]byte(`{"labels":{"a":"1"`), &testMaps{})
+	require.ErrorAs(t, err, new(InvalidJSONError))
+}
@@ -2,6 +2,7 @@ package gojay
 
 import (
 	"math"
+	"unsafe"
 )
 
 var digits []int8
@@ -70,9 +71,10 @@ func init() {
 }
 
 func (dec *Decoder) skipNumber() (int, error) {
+	dec.tokenStart = dec.cursor
 	end := dec.cursor + 1
 	// look for following numbers
-	for j := dec.cursor + 1; j < dec.length || dec.read(); j++ {
+	for j := dec.cursor + 1; j < dec.length || dec.readToken(); j++ {
 		end += skipNumberEndCursorIncrement[dec.data[j]]
 
 		switch dec.data[j] {
@@ -92,7 +94,7 @@ func (dec *Decoder) skipNumber() (int, error) {
 func (dec *Decoder) getExponent() (int64, error) {
 	start := dec.cursor
 	end := dec.cursor
-	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
+	for ; dec.cursor < dec.length || dec.readToken(); dec.cursor++ {
 		switch dec.data[dec.cursor] { // is positive
 		case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 			end = dec.cursor + 1
@@ -117,3 +119,158 @@ func (dec *Decoder) getExponent() (int64, error) {
 	}
 	return dec.atoi64(start, end-1), nil
 }
+
+// getNumber reads a number token starting at the cursor following the JSON number grammar
+// and returns its boundaries, the cursor is placed right after the last char of the number.
+//
+//nolint:gocognit,cyclop
+func (dec *Decoder) getNumber() (int, int, error) {
+	start := dec.cursor
+	dec.tokenStart = start
+	if dec.data[dec.cursor] == '-' {
+		dec.cursor++
+	}
+	// integer part, leading zeros are invalid
+	if !dec.scanDigits(true) {
+		return 0, 0, dec.raiseInvalidJSONErr(dec.cursor)
+	}
+	// fraction part
+	if (dec.cursor < dec.length || dec.readToken()) && dec.data[dec.cursor] == '.' {
+		dec.cursor++
+		if !dec.scanDigits(false) {
+			return 0, 0, dec.raiseInvalidJSONErr(dec.cursor)
+		}
+	}
+	// exponent part
+	if (dec.cursor < dec.length || dec.readToken()) && (dec.data[dec.cursor] == 'e' || dec.data[dec.cursor] == 'E') {
+		dec.cursor++
+		if (dec.cursor < dec.length || dec.readToken()) && (dec.data[dec.cursor] == '-' || dec.data[dec.cursor] == '+') {
+			dec.cursor++
+		}
+		if !dec.scanDigits(false) {
+			return 0, 0, dec.raiseInvalidJSONErr(dec.cursor)
+		}
+	}
+	// a number must be followed by a delimiter
+	if dec.cursor < dec.length || dec.readToken() {
+		switch dec.data[dec.cursor] {
+		case ' ', '\n', '\t', '\r', ',', '}', ']':
+		default:
+			return 0, 0, dec.raiseInvalidJSONErr(dec.cursor)
+		}
+	}
+	return start, dec.cursor, nil
+}
+
+// scanDigits moves the cursor after a sequence of at least one digit,
+// if noLeadingZero is true a sequence starting with 0 stops right after it.
+func (dec *Decoder) scanDigits(noLeadingZero bool) bool {
+	if (dec.cursor >= dec.length && !dec.readToken()) || !isDigit(dec.data[dec.cursor]) {
+		return false
+	}
+	if noLeadingZero && dec.data[dec.cursor] == '0' {
+		dec.cursor++
+		return true
+	}
+	dec.cursor++
+	for (dec.cursor < dec.length || dec.readToken()) && isDigit(dec.data[dec.cursor]) {
+		dec.cursor++
+	}
+	return true
+}
+
+// getNumberLiteral returns the literal of the next number to be decoded to v,
+// found is false if the value is null or is not a number, in which case an InvalidUnmarshalError is set on the Decoder.
+// The token is only valid until the Decoder reads more data.
+func (dec *Decoder) getNumberLiteral(v any) (string, bool, error) {
+	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
+		switch dec.data[dec.cursor] {
+		case ' ', '\n', '\t', '\r', ',':
+			continue
+		case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+			start, end, err := dec.getNumber()
+			if err != nil {
+				return "", false, err
+			}
+			d := dec.data[start:end]
+			return *(*string)(unsafe.Pointer(&d)), true, nil
+		case 'n':
+			dec.cursor++
+			return "", false, dec.assertNull()
+		default:
+			dec.err = dec.makeInvalidUnmarshalErr(v)
+			return "", false, dec.skipData()
+		}
+	}
+	return "", false, dec.raiseInvalidJSONErr(dec.cursor)
+}
+
+// DecodeNumber reads the next JSON-encoded value from the decoder's input (io.Reader) and stores its literal in the Number pointed to by v.
+//
+// See the documentation for Unmarshal for details about the conversion of JSON into a Go value.
+func (dec *Decoder) DecodeNumber(v *Number) error {
+	if dec.isPooled == 1 {
+		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
+	}
+	dec.compact()
+	return dec.decodeNumber(v)
+}
+
+func (dec *Decoder) decodeNumber(v *Number) error {
+	s, ok, err := dec.getNumberLiteral(v)
+	if !ok {
+		return err
+	}
+	*v = Number(s)
+	return nil
+}
+
+func (dec *Decoder) decodeNumberNull(v **Number) error {
+	s, ok, err := dec.getNumberLiteral(v)
+	if !ok {
+		return err
+	}
+	if *v == nil {
+		*v = new(Number)
+	}
+	**v = Number(s)
+	return nil
+}
+
+// Add Values functions
+
+// AddNumber decodes the JSON value within an object or an array to a *Number, keeping the literal of the number.
+// If next key value is not a JSON number nor null, InvalidUnmarshalError will be returned.
+func (dec *Decoder) AddNumber(v *Number) error {
+	return dec.Number(v)
+}
+
+// AddNumberNull decodes the JSON value within an object or an array to a **Number, keeping the literal of the number.
+// If next key value is not a JSON number nor null, InvalidUnmarshalError will be returned.
+// If a `null` is encountered, gojay does not change the value of the pointer.
+func (dec *Decoder) AddNumberNull(v **Number) error {
+	return dec.NumberNull(v)
+}
+
+// Number decodes the JSON value within an object or an array to a *Number, keeping the literal of the number.
+// If next key value is not a JSON number nor null, InvalidUnmarshalError will be returned.
+func (dec *Decoder) Number(v *Number) error {
+	err := dec.decodeNumber(v)
+	if err != nil {
+		return err
+	}
+	dec.called |= 1
+	return nil
+}
+
+// NumberNull decodes the JSON value within an object or an array to a **Number, keeping the literal of the number.
+// If next key value is not a JSON number nor null, InvalidUnmarshalError will be returned.
+// If a `null` is encountered, gojay does not change the value of the pointer.
+func (dec *Decoder) NumberNull(v **Number) error {
+	err := dec.decodeNumberNull(v)
+	if err != nil {
+		return err
+	}
+	dec.called |= 1
+	return nil
+}
@@ -0,0 +1,282 @@
+package gojay
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// DecodeBigInt reads the next JSON-encoded value from the decoder's input (io.Reader) and stores it in the big.Int pointed to by v.
+//
+// See the documentation for Unmarshal for details about the conversion of JSON into a Go value.
+func (dec *Decoder) DecodeBigInt(v *big.Int) error {
+	if dec.isPooled == 1 {
+		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
+	}
+	dec.compact()
+	return dec.decodeBigInt(v)
+}
+
+func (dec *Decoder) decodeBigInt(v *big.Int) error {
+	s, ok, err := dec.getNumberLiteral(v)
+	if !ok || !dec.isBigInt(v, s) {
+		return err
+	}
+	v.SetString(s, 10)
+	return nil
+}
+
+func (dec *Decoder) decodeBigIntNull(v **big.Int) error {
+	s, ok, err := dec.getNumberLiteral(v)
+	if !ok || !dec.isBigInt(v, s) {
+		return err
+	}
+	if *v == nil {
+		*v = new(big.Int)
+	}
+	(*v).SetString(s, 10)
+	return nil
+}
+
+// isBigInt reports whether the number s has no fraction nor exponent,
+// otherwise an InvalidUnmarshalError for v is set on the Decoder.
+func (dec *Decoder) isBigInt(v any, s string) bool {
+	if strings.ContainsAny(s, ".eE") {
+		dec.setBigNumberErr(v, s)
+		return false
+	}
+	return true
+}
+
+// isBigExponent reports whether the exponent of the number s is within the maximum of the Decoder,
+// otherwise an InvalidUnmarshalError for v is set on the Decoder.
+func (dec *Decoder) isBigExponent(v any, s string) bool {
+	i := strings.IndexAny(s, "eE")
+	if i < 0 || dec.maxBigExponent <= 0 {
+		return true
+	}
+	// the exponent follows the JSON grammar, Atoi only fails if it overflows
+	exp, err := strconv.Atoi(s[i+1:])
+	if err != nil || exp > dec.maxBigExponent || exp < -dec.maxBigExponent {
+		dec.setBigNumberErr(v, s)
+		return false
+	}
+	return true
+}
+
+// DecodeBigFloat reads the next JSON-encoded value from the decoder's input (io.Reader) and stores it in the big.Float pointed to by v.
+// If v has a zero precision, it is set to hold all the digits of the number.
+// A number with an exponent beyond the maximum of the Decoder fails with an InvalidUnmarshalError, see WithMaxBigExponent.
+//
+// See the documentation for Unmarshal for details about the conversion of JSON into a Go value.
+func (dec *Decoder) DecodeBigFloat(v *big.Float) error {
+	if dec.isPooled == 1 {
+		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
+	}
+	dec.compact()
+	return dec.decodeBigFloat(v)
+}
+
+func (dec *Decoder) decodeBigFloat(v *big.Float) error {
+	s, ok, err := dec.getNumberLiteral(v)
+	if !ok || !dec.isBigExponent(v, s) {
+		return err
+	}
+	f := dec.parseBigFloat(v, s, v.Prec())
+	if f != nil {
+		v.Set(f)
+	}
+	return nil
+}
+
+func (dec *Decoder) decodeBigFloatNull(v **big.Float) error {
+	s, ok, err := dec.getNumberLiteral(v)
+	if !ok || !dec.isBigExponent(v, s) {
+		return err
+	}
+	var prec uint
+	if *v != nil {
+		prec = (*v).Prec()
+	}
+	f := dec.parseBigFloat(v, s, prec)
+	if f == nil {
+		return nil
+	}
+	if *v == nil {
+		*v = f
+		return nil
+	}
+	(*v).Set(f)
+	return nil
+}
+
+// parseBigFloat parses the number s with the precision prec, or enough precision to hold all its digits if prec is 0.
+// It returns nil and sets an InvalidUnmarshalError for v on the Decoder if s is out of the range of a big.Float.
+func (dec *Decoder) parseBigFloat(v any, s string, prec uint) *big.Float {
+	if prec == 0 {
+		// a decimal digit takes less than 4 bits
+		prec = max(64, 4*uint(len(s)))
+	}
+	f, _, err := big.ParseFloat(s, 10, prec, big.ToNearestEven)
+	if err != nil {
+		dec.setBigNumberErr(v, s)
+		return nil
+	}
+	return f
+}
+
+// DecodeBigRat reads the next JSON-encoded value from the decoder's input (io.Reader) and stores it in the big.Rat pointed to by v.
+// The number is decoded exactly.
+// A number with an exponent beyond the maximum of the Decoder fails with an InvalidUnmarshalError, see WithMaxBigExponent.
+//
+// See the documentation for Unmarshal for details about the conversion of JSON into a Go value.
+func (dec *Decoder) DecodeBigRat(v *big.Rat) error {
+	if dec.isPooled == 1 {
+		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
+	}
+	dec.compact()
+	return dec.decodeBigRat(v)
+}
+
+func (dec *Decoder) decodeBigRat(v *big.Rat) error {
+	s, ok, err := dec.getNumberLiteral(v)
+	if !ok || !dec.isBigExponent(v, s) {
+		return err
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		dec.setBigNumberErr(v, s)
+		return nil
+	}
+	v.Set(r)
+	return nil
+}
+
+func (dec *Decoder) decodeBigRatNull(v **big.Rat) error {
+	s, ok, err := dec.getNumberLiteral(v)
+	if !ok || !dec.isBigExponent(v, s) {
+		return err
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		dec.setBigNumberErr(v, s)
+		return nil
+	}
+	*v = r
+	return nil
+}
+
+// setBigNumberErr sets an InvalidUnmarshalError for v on the Decoder, located at the number s just read.
+func (dec *Decoder) setBigNumberErr(v any, s string) {
+	dec.err = dec.makeDecodeErr(
+		InvalidUnmarshalError(fmt.Sprintf(invalidUnmarshalErrorMsg, v)),
+		dec.cursor-len(s),
+		fmt.Sprintf("%T", v),
+		"number",
+	)
+}
+
+// Add Values functions
+
+// AddBigInt decodes the JSON value within an object or an array to a *big.Int.
+// If next key value is not an integer, an InvalidUnmarshalError error will be returned.
+func (dec *Decoder) AddBigInt(v *big.Int) error {
+	return dec.BigInt(v)
+}
+
+// AddBigIntNull decodes the JSON value within an object or an array to a *big.Int.
+// If next key value is not an integer, an InvalidUnmarshalError error will be returned.
+// If a `null` is encountered, gojay does not change the value of the pointer.
+func (dec *Decoder) AddBigIntNull(v **big.Int) error {
+	return dec.BigIntNull(v)
+}
+
+// BigInt decodes the JSON value within an object or an array to a *big.Int.
+// If next key value is not an integer, an InvalidUnmarshalError error will be returned.
+func (dec *Decoder) BigInt(v *big.Int) error {
+	err := dec.decodeBigInt(v)
+	if err != nil {
+		return err
+	}
+	dec.called |= 1
+	return nil
+}
+
+// BigIntNull decodes the JSON value within an object or an array to a *big.Int.
+// If next key value is not an integer, an InvalidUnmarshalError error will be returned.
+// If a `null` is encountered, gojay does not change the value of the pointer.
+func (dec *Decoder) BigIntNull(v **big.Int) error {
+	err := dec.decodeBigIntNull(v)
+	if err != nil {
+		return err
+	}
+	dec.called |= 1
+	return nil
+}
+
+// AddBigFloat decodes the JSON value within an object or an array to a *big.Float.
+// If v has a zero precision, it is set to hold all the digits of the number.
+func (dec *Decoder) AddBigFloat(v *big.Float) error {
+	return dec.BigFloat(v)
+}
+
+// AddBigFloatNull decodes the JSON value within an object or an array to a *big.Float.
+// If a `null` is encountered, gojay does not change the value of the pointer.
+func (dec *Decoder) AddBigFloatNull(v **big.Float) error {
+	return dec.BigFloatNull(v)
+}
+
+// BigFloat decodes the JSON value within an object or an array to a *big.Float.
+// If v has a zero precision, it is set to hold all the digits of the number.
+func (dec *Decoder) BigFloat(v *big.Float) error {
+	err := dec.decodeBigFloat(v)
+	if err != nil {
+		return err
+	}
+	dec.called |= 1
+	return nil
+}
+
+// BigFloatNull decodes the JSON value within an object or an array to a *big.Float.
+// If a `null` is encountered, gojay does not change the value of the pointer.
+func (dec *Decoder) BigFloatNull(v **big.Float) error {
+	err := dec.decodeBigFloatNull(v)
+	if err != nil {
+		return err
+	}
+	dec.called |= 1
+	return nil
+}
+
+// AddBigRat decodes the JSON value within an object or an array to a *big.Rat.
+func (dec *Decoder) AddBigRat(v *big.Rat) error {
+	return dec.BigRat(v)
+}
+
+// AddBigRatNull decodes the JSON value within an object or an array to a *big.Rat.
+// If a `null` is encountered, gojay does not change the value of the pointer.
+func (dec *Decoder) AddBigRatNull(v **big.Rat) error {
+	return dec.BigRatNull(v)
+}
+
+// BigRat decodes the JSON value within an object or an array to a *big.Rat.
+func (dec *Decoder) BigRat(v *big.Rat) error {
+	err := dec.decodeBigRat(v)
+	if err != nil {
+		return err
+	}
+	dec.called |= 1
+	return nil
+}
+
+// BigRatNull decodes the JSON value within an object or an array to a *big.Rat.
+// If a `null` is encountered, gojay does not change the value of the pointer.
+func (dec *Decoder) BigRatNull(v **big.Rat) error {
+	err := dec.decodeBigRatNull(v)
+	if err != nil {
+		return err
+	}
+	dec.called |= 1
+	return nil
+}
@@ -0,0 +1,146 @@
+package gojay
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testBigNumbers struct {
+	amount   *big.Int
+	id       big.Int
+	rate     *big.Float
+	exact    big.Float
+	ratio    *big.Rat
+	fraction big.Rat
+}
+
+func (t *testBigNumbers) UnmarshalJSONObject(dec *Decoder, k string) error {
+	switch k {
+	case "amount":
+		return dec.BigIntNull(&t.amount)
+	case "id":
+		return dec.BigInt(&t.id)
+	case "rate":
+		return dec.BigFloatNull(&t.rate)
+	case "exact":
+		return dec.BigFloat(&t.exact)
+	case "ratio":
+		return dec.BigRatNull(&t.ratio)
+	case "fraction":
+		return dec.BigRat(&t.fraction)
+	}
+	return nil
+}
+
+func (t *testBigNumbers) NKeys() int {
+	return 6
+}
+
+func TestDecoderBigNumbers(t *testing.T) {
+	t.Parallel()
+
+	json := `{
+		"amount": -340282366920938463463374607431768211455,
+		"id": 18446744073709551616,
+		"rate": 0.1000000000000000000000000000001,
+		"exact": 1.5e40,
+		"ratio": 0.125,
+		"fraction": -1.5e-3
+	}`
+	v := &testBigNumbers{}
+	require.NoError(t, Unmarshal([]byte(json), v))
+	assert.Equal(t, "-340282366920938463463374607431768211455", v.amount.String())
+	assert.Equal(t, "18446744073709551616", v.id.String())
+	assert.Equal(t, "0.1000000000000000000000000000001", v.rate.Text('f', 31), "no float64 round-trip")
+	assert.Equal(t, "1.5e+40", v.exact.Text('g', 10))
+	assert.Equal(t, "1/8", v.ratio.String())
+	assert.Equal(t, "-3/2000", v.fraction.String())
+
+	v = &testBigNumbers{amount: big.NewInt(1), rate: big.NewFloat(1), ratio: big.NewRat(1, 2)}
+	require.NoError(t, Unmarshal([]byte(`{"amount":null,"rate":null,"ratio":null}`), v))
+	assert.Equal(t, "1", v.amount.String(), "null leaves the value untouched")
+	assert.Equal(t, "1", v.rate.String())
+	assert.Equal(t, "1/2", v.ratio.String())
+}
+
+func TestDecoderBigNumbersPrecision(t *testing.T) {
+	t.Parallel()
+
+	f := new(big.Float).SetPrec(24)
+	require.NoError(t, NewDecoder(strings.NewReader(`0.1000000000000000000000000000001`)).DecodeBigFloat(f))
+	assert.Equal(t, uint(24), f.Prec(), "a set precision is kept")
+	assert.Equal(t, "0.1", f.Text('g', 7))
+
+	i := new(big.Int)
+	require.NoError(t, NewDecoder(strings.NewReader(` 123456789012345678901234567890 `)).DecodeBigInt(i))
+	assert.Equal(t, "123456789012345678901234567890", i.String())
+
+	r := new(big.Rat)
+	require.NoError(t, NewDecoder(strings.NewReader(`1e-30`)).DecodeBigRat(r))
+	assert.Equal(t, "1/1000000000000000000000000000000", r.String())
+}
+
+func TestDecoderBigNumbersErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		json     string
+		expected string
+		found    string
+		offset   int
+		invalid  bool
+	}{
+		{name: "fraction-to-int", json: `{"id":1.5}`, expected: "*big.Int", found: "number", offset: 6},
+		{name: "exponent-to-int", json: `{"amount":1e3}`, expected: "**big.Int", found: "number", offset: 10},
+		{name: "string-to-int", json: `{"id":"1"}`, expected: "*big.Int", found: "string", offset: 6},
+		{name: "float-exponent-overflow", json: `{"rate":1e9999999999}`, expected: "**big.Float", found: "number", offset: 8},
+		{name: "rat-exponent-overflow", json: `{"fraction":1e9999999999}`, expected: "*big.Rat", found: "number", offset: 12},
+		{name: "float-huge-exponent", json: `{"exact":1e100000000}`, expected: "*big.Float", found: "number", offset: 9},
+		{name: "rat-huge-exponent", json: `{"ratio":1e1000000}`, expected: "**big.Rat", found: "number", offset: 9},
+		{name: "rat-huge-negative-exponent", json: `{"fraction":-5E-0001001}`, expected: "*big.Rat", found: "number", offset: 12},
+		{name: "invalid-number", json: `{"id":01}`, offset: 7, invalid: true},
+		{name: "invalid-literal", json: `{"rate":nul}`, invalid: true, offset: 11},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := Unmarshal([]byte(testCase.json), &testBigNumbers{})
+			var decErr *DecodeError
+			require.ErrorAs(t, err, &decErr)
+			assert.Equal(t, testCase.offset, decErr.Offset)
+			if testCase.invalid {
+				assert.ErrorAs(t, err, new(InvalidJSONError))
+				return
+			}
+			assert.ErrorAs(t, err, new(InvalidUnmarshalError))
+			assert.Equal(t, testCase.expected, decErr.Expected)
+			assert.Equal(t, testCase.found, decErr.Found)
+		})
+	}
+}
+
+func TestDecoderBigNumbersMaxExponent(t *testing.T) {
+	t.Parallel()
+
+	json := `{"exact":1e1000,"fraction":1e-1000}`
+	v := &testBigNumbers{}
+	require.NoError(t, Unmarshal([]byte(json), v))
+	assert.Equal(t, "1e+1000", v.exact.Text('g', 10))
+
+	json = `{"exact":1.5e1500,"fraction":2e-1500}`
+	err := Unmarshal([]byte(json), &testBigNumbers{})
+	require.ErrorAs(t, err, new(InvalidUnmarshalError))
+	v = &testBigNumbers{}
+	require.NoError(t, Unmarshal([]byte(json), v, WithMaxBigExponent(1500)))
+	assert.Equal(t, "1.5e+1500", v.exact.Text('g', 10))
+	assert.Equal(t, "1/"+"5"+strings.Repeat("0", 1499), v.fraction.String())
+	v = &testBigNumbers{}
+	require.NoError(t, Unmarshal([]byte(json), v, WithMaxBigExponent(0)))
+	assert.Equal(t, "1.5e+1500", v.exact.Text('g', 10))
+}
@@ -7,6 +7,7 @@ func (dec *Decoder) DecodeFloat64(v *float64) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	return dec.decodeFloat64(v)
 }
 
@@ -111,8 +112,9 @@ func (dec *Decoder) getFloatNegative() (float64, error) {
 func (dec *Decoder) getFloat() (float64, error) {
 	end := dec.cursor
 	start := dec.cursor
+	dec.tokenStart = start
 	// look for following numbers
-	for j := dec.cursor + 1; j < dec.length || dec.read(); j++ {
+	for j := dec.cursor + 1; j < dec.length || dec.readToken(); j++ {
 		switch dec.data[j] {
 		case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 			end = j
@@ -123,7 +125,7 @@ func (dec *Decoder) getFloat() (float64, error) {
 			// then we get part after decimal as integer
 			start = j + 1
 			// get number after the decimal point
-			for i := j + 1; i < dec.length || dec.read(); i++ {
+			for i := j + 1; i < dec.length || dec.readToken(); i++ {
 				c := dec.data[i]
 				//nolint:nestif
 				if isDigit(c) {
@@ -219,6 +221,7 @@ func (dec *Decoder) DecodeFloat32(v *float32) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	return dec.decodeFloat32(v)
 }
 
@@ -323,8 +326,9 @@ func (dec *Decoder) getFloat32Negative() (float32, error) {
 func (dec *Decoder) getFloat32() (float32, error) {
 	end := dec.cursor
 	start := dec.cursor
+	dec.tokenStart = start
 	// look for following numbers
-	for j := dec.cursor + 1; j < dec.length || dec.read(); j++ {
+	for j := dec.cursor + 1; j < dec.length || dec.readToken(); j++ {
 		switch dec.data[j] {
 		case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 			end = j
@@ -336,7 +340,7 @@ func (dec *Decoder) getFloat32() (float32, error) {
 			start = j + 1
 			// get number after the decimal point
 			// multiply the before decimal point portion by 10 using bitwise
-			for i := j + 1; i < dec.length || dec.read(); i++ {
+			for i := j + 1; i < dec.length || dec.readToken(); i++ {
 				c := dec.data[i]
 				//nolint:nestif
 				if isDigit(c) {
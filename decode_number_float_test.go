@@ -51,28 +51,28 @@ func TestDecoderFloat64(t *testing.T) {
 			json:           "nxll",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-negative-err",
 			json:           "-",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-negative-err",
 			json:           "-q",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-null-err",
 			json:           "trua",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-err1",
@@ -240,7 +240,7 @@ func TestDecoderFloat64(t *testing.T) {
 			json:           "83zez4",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "exponent-err",
@@ -259,14 +259,14 @@ func TestDecoderFloat64(t *testing.T) {
 			json:           "-83zez4",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "invalid-type",
 			json:           `"string"`,
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "big float",
@@ -284,10 +284,10 @@ func TestDecoderFloat64(t *testing.T) {
 			if testCase.err {
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(
+					assert.ErrorAs(
 						t,
-						testCase.errType,
 						err,
+						testCase.errType,
 						"err should be of type %s",
 						reflect.TypeOf(err).String(),
 					)
@@ -349,7 +349,7 @@ func TestDecoderFloat64(t *testing.T) {
 		defer dec.Release()
 		err := dec.DecodeFloat64(&v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 }
 
@@ -395,7 +395,7 @@ func TestDecoderFloat64Null(t *testing.T) {
 			json:           "nxll",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 			resultIsNil:    true,
 		},
 		{
@@ -403,7 +403,7 @@ func TestDecoderFloat64Null(t *testing.T) {
 			json:           "-",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 			resultIsNil:    true,
 		},
 		{
@@ -411,7 +411,7 @@ func TestDecoderFloat64Null(t *testing.T) {
 			json:           "-q",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 			resultIsNil:    true,
 		},
 		{
@@ -419,7 +419,7 @@ func TestDecoderFloat64Null(t *testing.T) {
 			json:           "trua",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 			resultIsNil:    true,
 		},
 		{
@@ -593,7 +593,7 @@ func TestDecoderFloat64Null(t *testing.T) {
 			json:           "83zez4",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 			resultIsNil:    true,
 		},
 		{
@@ -615,7 +615,7 @@ func TestDecoderFloat64Null(t *testing.T) {
 			json:           "-83zez4",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 			resultIsNil:    true,
 		},
 		{
@@ -623,7 +623,7 @@ func TestDecoderFloat64Null(t *testing.T) {
 			json:           `"string"`,
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 			resultIsNil:    true,
 		},
 	}
@@ -637,10 +637,10 @@ func TestDecoderFloat64Null(t *testing.T) {
 			if testCase.err {
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(
+					assert.ErrorAs(
 						t,
-						testCase.errType,
 						err,
+						testCase.errType,
 						"err should be of type %s", reflect.TypeOf(err).String(),
 					)
 				}
@@ -667,7 +667,7 @@ func TestDecoderFloat64Null(t *testing.T) {
 		v := new(float64)
 		err := Unmarshal([]byte(``), &v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 	t.Run("decoder-api-invalid-json2", func(t *testing.T) {
 		t.Parallel()
@@ -676,7 +676,7 @@ func TestDecoderFloat64Null(t *testing.T) {
 		dec := NewDecoder(strings.NewReader(``))
 		err := dec.FloatNull(&v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 	t.Run("decoder-api-invalid-json2", func(t *testing.T) {
 		t.Parallel()
@@ -685,7 +685,7 @@ func TestDecoderFloat64Null(t *testing.T) {
 		dec := NewDecoder(strings.NewReader(``))
 		err := dec.AddFloat64Null(&v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 }
 
@@ -769,21 +769,21 @@ func TestDecoderFloat32(t *testing.T) {
 			json:           "trua",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-null-err",
 			json:           "nxll",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-negative-err",
 			json:           "-",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "exponent-err-",
@@ -796,7 +796,7 @@ func TestDecoderFloat32(t *testing.T) {
 			json:           "-q",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-exponent-positive-positive-exp4",
@@ -922,14 +922,14 @@ func TestDecoderFloat32(t *testing.T) {
 			json:           "83zez4",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error",
 			json:           "-83zez4",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "exponent-err",
@@ -942,7 +942,7 @@ func TestDecoderFloat32(t *testing.T) {
 			json:           `"string"`,
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 	}
 	for _, testCase := range testCases {
@@ -955,10 +955,10 @@ func TestDecoderFloat32(t *testing.T) {
 			if testCase.err {
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(
+					assert.ErrorAs(
 						t,
-						testCase.errType,
 						err,
+						testCase.errType,
 						"err should be of type %s", reflect.TypeOf(err).String(),
 					)
 				}
@@ -1019,7 +1019,7 @@ func TestDecoderFloat32(t *testing.T) {
 		defer dec.Release()
 		err := dec.DecodeFloat32(&v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 }
 
@@ -1111,7 +1111,7 @@ func TestDecoderFloat32Null(t *testing.T) {
 			json:           "trua",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 			resultIsNil:    true,
 		},
 		{
@@ -1119,7 +1119,7 @@ func TestDecoderFloat32Null(t *testing.T) {
 			json:           "nxll",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 			resultIsNil:    true,
 		},
 		{
@@ -1127,7 +1127,7 @@ func TestDecoderFloat32Null(t *testing.T) {
 			json:           "-",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 			resultIsNil:    true,
 		},
 		{
@@ -1142,7 +1142,7 @@ func TestDecoderFloat32Null(t *testing.T) {
 			json:           "-q",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 			resultIsNil:    true,
 		},
 		{
@@ -1268,7 +1268,7 @@ func TestDecoderFloat32Null(t *testing.T) {
 			json:           "83zez4",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 			resultIsNil:    true,
 		},
 		{
@@ -1276,7 +1276,7 @@ func TestDecoderFloat32Null(t *testing.T) {
 			json:           "-83zez4",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 			resultIsNil:    true,
 		},
 		{
@@ -1291,7 +1291,7 @@ func TestDecoderFloat32Null(t *testing.T) {
 			json:           `"string"`,
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 			resultIsNil:    true,
 		},
 	}
@@ -1305,10 +1305,10 @@ func TestDecoderFloat32Null(t *testing.T) {
 			if testCase.err {
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(
+					assert.ErrorAs(
 						t,
-						testCase.errType,
 						err,
+						testCase.errType,
 						"err should be of type %s", reflect.TypeOf(err).String(),
 					)
 				}
@@ -1335,7 +1335,7 @@ func TestDecoderFloat32Null(t *testing.T) {
 		v := new(float32)
 		err := Unmarshal([]byte(``), &v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 	t.Run("decoder-api-invalid-json2", func(t *testing.T) {
 		t.Parallel()
@@ -1344,7 +1344,7 @@ func TestDecoderFloat32Null(t *testing.T) {
 		dec := NewDecoder(strings.NewReader(``))
 		err := dec.Float32Null(&v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 }
 
@@ -12,6 +12,7 @@ func (dec *Decoder) DecodeInt(v *int) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	return dec.decodeInt(v)
 }
 
@@ -44,13 +45,15 @@ func (dec *Decoder) decodeInt(v *int) error {
 			}
 			return nil
 		default:
-			dec.err = InvalidUnmarshalError(
+			decErr := dec.makeDecodeErr(nil, dec.cursor, fmt.Sprintf("%T", v), dec.foundKind(dec.cursor))
+			decErr.Err = InvalidUnmarshalError(
 				fmt.Sprintf(
 					"Cannot unmarshall to int, wrong char '%s' found at pos %d",
 					string(dec.data[dec.cursor]),
-					dec.cursor,
+					decErr.Offset,
 				),
 			)
+			dec.err = decErr
 			err := dec.skipData()
 			if err != nil {
 				return err
@@ -96,13 +99,15 @@ func (dec *Decoder) decodeIntNull(v **int) error {
 			}
 			return nil
 		default:
-			dec.err = InvalidUnmarshalError(
+			decErr := dec.makeDecodeErr(nil, dec.cursor, fmt.Sprintf("%T", v), dec.foundKind(dec.cursor))
+			decErr.Err = InvalidUnmarshalError(
 				fmt.Sprintf(
 					"Cannot unmarshall to int, wrong char '%s' found at pos %d",
 					string(dec.data[dec.cursor]),
-					dec.cursor,
+					decErr.Offset,
 				),
 			)
+			dec.err = decErr
 			err := dec.skipData()
 			if err != nil {
 				return err
@@ -120,6 +125,7 @@ func (dec *Decoder) DecodeInt16(v *int16) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	return dec.decodeInt16(v)
 }
 
@@ -226,8 +232,9 @@ func (dec *Decoder) getInt16Negative() (int16, error) {
 func (dec *Decoder) getInt16() (int16, error) {
 	end := dec.cursor
 	start := dec.cursor
+	dec.tokenStart = start
 	// look for following numbers
-	for j := dec.cursor + 1; j < dec.length || dec.read(); j++ {
+	for j := dec.cursor + 1; j < dec.length || dec.readToken(); j++ {
 		switch dec.data[j] {
 		case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 			end = j
@@ -240,7 +247,7 @@ func (dec *Decoder) getInt16() (int16, error) {
 			j++
 			startDecimal := j
 			endDecimal := j - 1
-			for ; j < dec.length || dec.read(); j++ {
+			for ; j < dec.length || dec.readToken(); j++ {
 				switch dec.data[j] {
 				case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 					endDecimal = j
@@ -306,7 +313,7 @@ func (dec *Decoder) getInt16() (int16, error) {
 func (dec *Decoder) getInt16WithExp(init int16) (int16, error) {
 	var exp uint16
 	sign := int16(1)
-	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
+	for ; dec.cursor < dec.length || dec.readToken(); dec.cursor++ {
 		switch dec.data[dec.cursor] {
 		case '+':
 			continue
@@ -316,7 +323,7 @@ func (dec *Decoder) getInt16WithExp(init int16) (int16, error) {
 			uintv := uint16(digits[dec.data[dec.cursor]])
 			exp = (exp << 3) + (exp << 1) + uintv
 			dec.cursor++
-			for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
+			for ; dec.cursor < dec.length || dec.readToken(); dec.cursor++ {
 				switch dec.data[dec.cursor] {
 				case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 					uintv := uint16(digits[dec.data[dec.cursor]])
@@ -354,6 +361,7 @@ func (dec *Decoder) DecodeInt8(v *int8) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	return dec.decodeInt8(v)
 }
 
@@ -460,8 +468,9 @@ func (dec *Decoder) getInt8Negative() (int8, error) {
 func (dec *Decoder) getInt8() (int8, error) {
 	end := dec.cursor
 	start := dec.cursor
+	dec.tokenStart = start
 	// look for following numbers
-	for j := dec.cursor + 1; j < dec.length || dec.read(); j++ {
+	for j := dec.cursor + 1; j < dec.length || dec.readToken(); j++ {
 		switch dec.data[j] {
 		case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 			end = j
@@ -474,7 +483,7 @@ func (dec *Decoder) getInt8() (int8, error) {
 			j++
 			startDecimal := j
 			endDecimal := j - 1
-			for ; j < dec.length || dec.read(); j++ {
+			for ; j < dec.length || dec.readToken(); j++ {
 				switch dec.data[j] {
 				case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 					endDecimal = j
@@ -540,7 +549,7 @@ func (dec *Decoder) getInt8() (int8, error) {
 func (dec *Decoder) getInt8WithExp(init int8) (int8, error) {
 	var exp uint8
 	sign := int8(1)
-	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
+	for ; dec.cursor < dec.length || dec.readToken(); dec.cursor++ {
 		switch dec.data[dec.cursor] {
 		case '+':
 			continue
@@ -550,7 +559,7 @@ func (dec *Decoder) getInt8WithExp(init int8) (int8, error) {
 			uintv := uint8(digits[dec.data[dec.cursor]])
 			exp = (exp << 3) + (exp << 1) + uintv
 			dec.cursor++
-			for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
+			for ; dec.cursor < dec.length || dec.readToken(); dec.cursor++ {
 				switch dec.data[dec.cursor] {
 				case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 					uintv := uint8(digits[dec.data[dec.cursor]])
@@ -589,6 +598,7 @@ func (dec *Decoder) DecodeInt32(v *int32) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	return dec.decodeInt32(v)
 }
 
@@ -693,8 +703,9 @@ func (dec *Decoder) getInt32Negative() (int32, error) {
 func (dec *Decoder) getInt32() (int32, error) {
 	end := dec.cursor
 	start := dec.cursor
+	dec.tokenStart = start
 	// look for following numbers
-	for j := dec.cursor + 1; j < dec.length || dec.read(); j++ {
+	for j := dec.cursor + 1; j < dec.length || dec.readToken(); j++ {
 		switch dec.data[j] {
 		case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 			end = j
@@ -707,7 +718,7 @@ func (dec *Decoder) getInt32() (int32, error) {
 			j++
 			startDecimal := j
 			endDecimal := j - 1
-			for ; j < dec.length || dec.read(); j++ {
+			for ; j < dec.length || dec.readToken(); j++ {
 				switch dec.data[j] {
 				case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 					endDecimal = j
@@ -774,7 +785,7 @@ func (dec *Decoder) getInt32() (int32, error) {
 func (dec *Decoder) getInt32WithExp(init int32) (int32, error) {
 	var exp uint32
 	sign := int32(1)
-	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
+	for ; dec.cursor < dec.length || dec.readToken(); dec.cursor++ {
 		switch dec.data[dec.cursor] {
 		case '+':
 			continue
@@ -784,7 +795,7 @@ func (dec *Decoder) getInt32WithExp(init int32) (int32, error) {
 			uintv := uint32(digits[dec.data[dec.cursor]])
 			exp = (exp << 3) + (exp << 1) + uintv
 			dec.cursor++
-			for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
+			for ; dec.cursor < dec.length || dec.readToken(); dec.cursor++ {
 				switch dec.data[dec.cursor] {
 				case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 					uintv := uint32(digits[dec.data[dec.cursor]])
@@ -823,6 +834,7 @@ func (dec *Decoder) DecodeInt64(v *int64) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	return dec.decodeInt64(v)
 }
 
@@ -927,8 +939,9 @@ func (dec *Decoder) getInt64Negative() (int64, error) {
 func (dec *Decoder) getInt64() (int64, error) {
 	end := dec.cursor
 	start := dec.cursor
+	dec.tokenStart = start
 	// look for following numbers
-	for j := dec.cursor + 1; j < dec.length || dec.read(); j++ {
+	for j := dec.cursor + 1; j < dec.length || dec.readToken(); j++ {
 		switch dec.data[j] {
 		case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 			end = j
@@ -944,7 +957,7 @@ func (dec *Decoder) getInt64() (int64, error) {
 			j++
 			startDecimal := j
 			endDecimal := j - 1
-			for ; j < dec.length || dec.read(); j++ {
+			for ; j < dec.length || dec.readToken(); j++ {
 				switch dec.data[j] {
 				case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 					endDecimal = j
@@ -1008,7 +1021,7 @@ func (dec *Decoder) getInt64() (int64, error) {
 func (dec *Decoder) getInt64WithExp(init int64) (int64, error) {
 	var exp uint64
 	sign := int64(1)
-	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
+	for ; dec.cursor < dec.length || dec.readToken(); dec.cursor++ {
 		switch dec.data[dec.cursor] {
 		case '+':
 			continue
@@ -1018,7 +1031,7 @@ func (dec *Decoder) getInt64WithExp(init int64) (int64, error) {
 			uintv := uint64(digits[dec.data[dec.cursor]])
 			exp = (exp << 3) + (exp << 1) + uintv
 			dec.cursor++
-			for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
+			for ; dec.cursor < dec.length || dec.readToken(); dec.cursor++ {
 				switch dec.data[dec.cursor] {
 				case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 					uintv := uint64(digits[dec.data[dec.cursor]])
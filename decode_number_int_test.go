@@ -45,28 +45,28 @@ func TestDecoderInt(t *testing.T) {
 			json:           "-",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-negative-err",
 			json:           "-q",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-null-err",
 			json:           "nxll",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-skip-data-err",
 			json:           "trua",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-big",
@@ -78,21 +78,21 @@ func TestDecoderInt(t *testing.T) {
 			json:           "9223372036854775808",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "basic-big-overflow2",
 			json:           "92233720368547758089",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "basic-big-overflow3",
 			json:           "92233720368547758089 ",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "basic-negative2",
@@ -202,21 +202,21 @@ func TestDecoderInt(t *testing.T) {
 			json:           " -1213xdde2323 ",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error3",
 			json:           "-8e+00$aa5",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error4",
 			json:           "0.E----",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "exponent-err-",
@@ -229,14 +229,14 @@ func TestDecoderInt(t *testing.T) {
 			json:           "0E40",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error6",
 			json:           "0.e-9",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 
 		{
@@ -244,14 +244,14 @@ func TestDecoderInt(t *testing.T) {
 			json:           "-5.e-2",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "invalid-type",
 			json:           `"string"`,
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "error_divide_by_zero",
@@ -296,10 +296,10 @@ func TestDecoderInt(t *testing.T) {
 			if testCase.err {
 				require.Error(t, err)
 				if testCase.errType != nil && err != nil {
-					assert.IsType(
+					assert.ErrorAs(
 						t,
-						testCase.errType,
 						err,
+						testCase.errType,
 						"err should be of type %s", reflect.TypeOf(err).String(),
 					)
 				}
@@ -356,7 +356,7 @@ func TestDecoderInt(t *testing.T) {
 		defer dec.Release()
 		err := dec.DecodeInt(&v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 }
 
@@ -397,28 +397,28 @@ func TestDecoderIntNull(t *testing.T) {
 			json:           "-",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-negative-err",
 			json:           "-q",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-null-err",
 			json:           "nxll",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-skip-data-err",
 			json:           "trua",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-big",
@@ -430,21 +430,21 @@ func TestDecoderIntNull(t *testing.T) {
 			json:           "9223372036854775808",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "basic-big-overflow2",
 			json:           "92233720368547758089",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "basic-big-overflow3",
 			json:           "92233720368547758089 ",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "basic-negative2",
@@ -554,21 +554,21 @@ func TestDecoderIntNull(t *testing.T) {
 			json:           " -1213xdde2323 ",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error3",
 			json:           "-8e+00$aa5",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error4",
 			json:           "0.E----",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "exponent-err-",
@@ -581,14 +581,14 @@ func TestDecoderIntNull(t *testing.T) {
 			json:           "0E40",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error6",
 			json:           "0.e-9",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 
 		{
@@ -596,14 +596,14 @@ func TestDecoderIntNull(t *testing.T) {
 			json:           "-5.e-2",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "invalid-type",
 			json:           `"string"`,
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "error_divide_by_zero",
@@ -648,10 +648,10 @@ func TestDecoderIntNull(t *testing.T) {
 			if testCase.err {
 				require.Error(t, err)
 				if testCase.errType != nil && err != nil {
-					assert.IsType(
+					assert.ErrorAs(
 						t,
-						testCase.errType,
 						err,
+						testCase.errType,
 						"err should be of type %s",
 						reflect.TypeOf(err).String(),
 					)
@@ -678,7 +678,7 @@ func TestDecoderIntNull(t *testing.T) {
 		v := new(int)
 		err := Unmarshal([]byte(``), &v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 	t.Run("decoder-api-invalid-json2", func(t *testing.T) {
 		t.Parallel()
@@ -687,7 +687,7 @@ func TestDecoderIntNull(t *testing.T) {
 		dec := NewDecoder(strings.NewReader(``))
 		err := dec.IntNull(&v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 }
 
@@ -726,28 +726,28 @@ func TestDecoderInt64(t *testing.T) {
 			json:           "nxll",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-negative-err",
 			json:           "-",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-negative-err",
 			json:           "-q",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-skip-data-err",
 			json:           "trua",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-big",
@@ -845,7 +845,7 @@ func TestDecoderInt64(t *testing.T) {
 			json:           "0E40",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-exponent-positive-negative-exp4",
@@ -906,7 +906,7 @@ func TestDecoderInt64(t *testing.T) {
 			json:           "8ea+00a5",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-exponent-err",
@@ -925,14 +925,14 @@ func TestDecoderInt64(t *testing.T) {
 			json:           "0.E----",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error5",
 			json:           "0E40",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "exponent-err-",
@@ -945,21 +945,21 @@ func TestDecoderInt64(t *testing.T) {
 			json:           "0.e-9",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error7",
 			json:           "-5.e-2",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "invalid-type",
 			json:           `"string"`,
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "error_divide_by_zero",
@@ -1009,10 +1009,10 @@ func TestDecoderInt64(t *testing.T) {
 			if testCase.err {
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(
+					assert.ErrorAs(
 						t,
-						testCase.errType,
 						err,
+						testCase.errType,
 						"err should be of type %s", reflect.TypeOf(err).String(),
 					)
 				}
@@ -1069,7 +1069,7 @@ func TestDecoderInt64(t *testing.T) {
 		defer dec.Release()
 		err := dec.DecodeInt64(&v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 }
 
@@ -1110,28 +1110,28 @@ func TestDecoderInt64Null(t *testing.T) {
 			json:           "nxll",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-negative-err",
 			json:           "-",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-negative-err",
 			json:           "-q",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-skip-data-err",
 			json:           "trua",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-big",
@@ -1223,7 +1223,7 @@ func TestDecoderInt64Null(t *testing.T) {
 			json:           "0E40",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-exponent-positive-negative-exp4",
@@ -1284,7 +1284,7 @@ func TestDecoderInt64Null(t *testing.T) {
 			json:           "8ea+00a5",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-exponent-err",
@@ -1303,14 +1303,14 @@ func TestDecoderInt64Null(t *testing.T) {
 			json:           "0.E----",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error5",
 			json:           "0E40",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "exponent-err-",
@@ -1323,21 +1323,21 @@ func TestDecoderInt64Null(t *testing.T) {
 			json:           "0.e-9",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error7",
 			json:           "-5.e-2",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "invalid-type",
 			json:           `"string"`,
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "error_divide_by_zero",
@@ -1382,10 +1382,10 @@ func TestDecoderInt64Null(t *testing.T) {
 			if testCase.err {
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(
+					assert.ErrorAs(
 						t,
-						testCase.errType,
 						err,
+						testCase.errType,
 						"err should be of type %s", reflect.TypeOf(err).String(),
 					)
 				}
@@ -1411,7 +1411,7 @@ func TestDecoderInt64Null(t *testing.T) {
 		v := new(int64)
 		err := Unmarshal([]byte(``), &v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 	t.Run("decoder-api-invalid-json2", func(t *testing.T) {
 		t.Parallel()
@@ -1420,7 +1420,7 @@ func TestDecoderInt64Null(t *testing.T) {
 		dec := NewDecoder(strings.NewReader(``))
 		err := dec.Int64Null(&v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 }
 
@@ -1459,28 +1459,28 @@ func TestDecoderInt32(t *testing.T) {
 			json:           "nxll",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-negative-err",
 			json:           "-",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-negative-err",
 			json:           "-q",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-skip-data-err",
 			json:           "trua",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-negative2",
@@ -1661,35 +1661,35 @@ func TestDecoderInt32(t *testing.T) {
 			json:           "0E40",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error4",
 			json:           "0.E----",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error5",
 			json:           "0E40",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error6",
 			json:           "0.e-9",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error7",
 			json:           "-5.e-2",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-float",
@@ -1701,14 +1701,14 @@ func TestDecoderInt32(t *testing.T) {
 			json:           "83zez4",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error",
 			json:           "8ea00$aa5",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error2",
@@ -1721,7 +1721,7 @@ func TestDecoderInt32(t *testing.T) {
 			json:           `"string"`,
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "error_divide_by_zero",
@@ -1766,10 +1766,10 @@ func TestDecoderInt32(t *testing.T) {
 			if testCase.err {
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(
+					assert.ErrorAs(
 						t,
-						testCase.errType,
 						err,
+						testCase.errType,
 						"err should be of type %s", reflect.TypeOf(err).String(),
 					)
 				}
@@ -1826,7 +1826,7 @@ func TestDecoderInt32(t *testing.T) {
 		defer dec.Release()
 		err := dec.DecodeInt32(&v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 }
 
@@ -1867,28 +1867,28 @@ func TestDecoderInt32Null(t *testing.T) {
 			json:           "nxll",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-negative-err",
 			json:           "-",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-negative-err",
 			json:           "-q",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-skip-data-err",
 			json:           "trua",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-negative2",
@@ -2063,35 +2063,35 @@ func TestDecoderInt32Null(t *testing.T) {
 			json:           "0E40",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error4",
 			json:           "0.E----",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error5",
 			json:           "0E40",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error6",
 			json:           "0.e-9",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error7",
 			json:           "-5.e-2",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-float",
@@ -2103,14 +2103,14 @@ func TestDecoderInt32Null(t *testing.T) {
 			json:           "83zez4",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error",
 			json:           "8ea00$aa5",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error2",
@@ -2123,7 +2123,7 @@ func TestDecoderInt32Null(t *testing.T) {
 			json:           `"string"`,
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "basic-exponent-positive-negative-exp4",
@@ -2163,10 +2163,10 @@ func TestDecoderInt32Null(t *testing.T) {
 			if testCase.err {
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(
+					assert.ErrorAs(
 						t,
-						testCase.errType,
 						err,
+						testCase.errType,
 						"err should be of type %s", reflect.TypeOf(err).String(),
 					)
 				}
@@ -2193,7 +2193,7 @@ func TestDecoderInt32Null(t *testing.T) {
 		v := new(int32)
 		err := Unmarshal([]byte(``), &v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 	t.Run("decoder-api-invalid-json2", func(t *testing.T) {
 		t.Parallel()
@@ -2202,7 +2202,7 @@ func TestDecoderInt32Null(t *testing.T) {
 		dec := NewDecoder(strings.NewReader(``))
 		err := dec.Int32Null(&v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 }
 
@@ -2241,28 +2241,28 @@ func TestDecoderInt16(t *testing.T) {
 			json:           "nxll",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-negative-err",
 			json:           "-",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-negative-err",
 			json:           "-q",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-skip-data-err",
 			json:           "trua",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-negative2",
@@ -2435,14 +2435,14 @@ func TestDecoderInt16(t *testing.T) {
 			json:           "83zez4",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error",
 			json:           "8ea00$aa5",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error2",
@@ -2455,49 +2455,49 @@ func TestDecoderInt16(t *testing.T) {
 			json:           "0E40",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error4",
 			json:           "0.E----",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error5",
 			json:           "0E40",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error6",
 			json:           "0.e-9",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error7",
 			json:           "0.e",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error8",
 			json:           "-5.e-2",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "invalid-type",
 			json:           `"string"`,
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "error_divide_by_zero",
@@ -2515,10 +2515,10 @@ func TestDecoderInt16(t *testing.T) {
 			if testCase.err {
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(
+					assert.ErrorAs(
 						t,
-						testCase.errType,
 						err,
+						testCase.errType,
 						"err should be of type %s", reflect.TypeOf(err).String(),
 					)
 				}
@@ -2575,7 +2575,7 @@ func TestDecoderInt16(t *testing.T) {
 		defer dec.Release()
 		err := dec.DecodeInt16(&v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 }
 
@@ -2616,28 +2616,28 @@ func TestDecoderInt16Null(t *testing.T) {
 			json:           "nxll",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-negative-err",
 			json:           "-",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-negative-err",
 			json:           "-q",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-skip-data-err",
 			json:           "trua",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-negative2",
@@ -2805,14 +2805,14 @@ func TestDecoderInt16Null(t *testing.T) {
 			json:           "83zez4",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error",
 			json:           "8ea00$aa5",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error2",
@@ -2825,49 +2825,49 @@ func TestDecoderInt16Null(t *testing.T) {
 			json:           "0E40",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error4",
 			json:           "0.E----",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error5",
 			json:           "0E40",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error6",
 			json:           "0.e-9",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error7",
 			json:           "0.e",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error8",
 			json:           "-5.e-2",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "invalid-type",
 			json:           `"string"`,
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "basic-exponent-positive-negative-exp3",
@@ -2907,10 +2907,10 @@ func TestDecoderInt16Null(t *testing.T) {
 			if testCase.err {
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(
+					assert.ErrorAs(
 						t,
-						testCase.errType,
 						err,
+						testCase.errType,
 						"err should be of type %s", reflect.TypeOf(err).String(),
 					)
 				}
@@ -2936,7 +2936,7 @@ func TestDecoderInt16Null(t *testing.T) {
 		v := new(int16)
 		err := Unmarshal([]byte(``), &v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 	t.Run("decoder-api-invalid-json2", func(t *testing.T) {
 		t.Parallel()
@@ -2945,7 +2945,7 @@ func TestDecoderInt16Null(t *testing.T) {
 		dec := NewDecoder(strings.NewReader(``))
 		err := dec.Int16Null(&v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 }
 
@@ -2984,28 +2984,28 @@ func TestDecoderInt8(t *testing.T) {
 			json:           "-",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-negative-err",
 			json:           "-q",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-null-err",
 			json:           "nxll",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-skip-data-err",
 			json:           "trua",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-negative2",
@@ -3128,7 +3128,7 @@ func TestDecoderInt8(t *testing.T) {
 			json:           "0E40",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "exponent-err-",
@@ -3187,14 +3187,14 @@ func TestDecoderInt8(t *testing.T) {
 			json:           "83zez4",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error",
 			json:           "8ea00$aa5",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error2",
@@ -3207,49 +3207,49 @@ func TestDecoderInt8(t *testing.T) {
 			json:           "0.E----",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error5",
 			json:           "0E40",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error6",
 			json:           "0.e-9",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error7",
 			json:           "0.e",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error8",
 			json:           "-5.e-2",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error8",
 			json:           "-5.01e",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "invalid-type",
 			json:           `"string"`,
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "basic-exponent-positive-negative-exp1",
@@ -3289,10 +3289,10 @@ func TestDecoderInt8(t *testing.T) {
 			if testCase.err {
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(
+					assert.ErrorAs(
 						t,
-						testCase.errType,
 						err,
+						testCase.errType,
 						"err should be of type %s", reflect.TypeOf(err).String(),
 					)
 				}
@@ -3349,7 +3349,7 @@ func TestDecoderInt8(t *testing.T) {
 		defer dec.Release()
 		err := dec.DecodeInt8(&v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 }
 
@@ -3390,28 +3390,28 @@ func TestDecoderInt8Null(t *testing.T) {
 			json:           "-",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-negative-err",
 			json:           "-q",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-null-err",
 			json:           "nxll",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-skip-data-err",
 			json:           "trua",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-negative2",
@@ -3533,7 +3533,7 @@ func TestDecoderInt8Null(t *testing.T) {
 			json:           "0E40",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "exponent-err-",
@@ -3586,14 +3586,14 @@ func TestDecoderInt8Null(t *testing.T) {
 			json:           "83zez4",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error",
 			json:           "8ea00$aa5",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error2",
@@ -3606,49 +3606,49 @@ func TestDecoderInt8Null(t *testing.T) {
 			json:           "0.E----",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error5",
 			json:           "0E40",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error6",
 			json:           "0.e-9",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error7",
 			json:           "0.e",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error8",
 			json:           "-5.e-2",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error8",
 			json:           "-5.01e",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "invalid-type",
 			json:           `"string"`,
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "basic-exponent-positive-negative-exp1",
@@ -3688,10 +3688,10 @@ func TestDecoderInt8Null(t *testing.T) {
 			if testCase.err {
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(
+					assert.ErrorAs(
 						t,
-						testCase.errType,
 						err,
+						testCase.errType,
 						"err should be of type %s",
 						reflect.TypeOf(err).String(),
 					)
@@ -3718,7 +3718,7 @@ func TestDecoderInt8Null(t *testing.T) {
 		v := new(int8)
 		err := Unmarshal([]byte(``), &v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 	t.Run("decoder-api-invalid-json2", func(t *testing.T) {
 		t.Parallel()
@@ -3727,6 +3727,6 @@ func TestDecoderInt8Null(t *testing.T) {
 		dec := NewDecoder(strings.NewReader(``))
 		err := dec.Int8Null(&v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 }
@@ -17,7 +17,7 @@ func TestDecodeNumberExra(t *testing.T) {
 		dec := NewDecoder(strings.NewReader("123456afzfz343"))
 		_, err := dec.skipNumber()
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 	t.Run("get-exponent-err", func(t *testing.T) {
 		t.Parallel()
@@ -26,6 +26,71 @@ func TestDecodeNumberExra(t *testing.T) {
 		dec := NewDecoder(strings.NewReader("1.2Ea"))
 		err := dec.Decode(&v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 }
+
+type testNumbers struct {
+	id     Number
+	amount *Number
+	items  []Number
+}
+
+func (t *testNumbers) UnmarshalJSONObject(dec *Decoder, k string) error {
+	switch k {
+	case "id":
+		return dec.Number(&t.id)
+	case "amount":
+		return dec.NumberNull(&t.amount)
+	case "items":
+		return dec.Array(DecodeArrayFunc(func(dec *Decoder) error {
+			var n Number
+			if err := dec.AddNumber(&n); err != nil {
+				return err
+			}
+			t.items = append(t.items, n)
+			return nil
+		}))
+	}
+	return nil
+}
+
+func (t *testNumbers) NKeys() int {
+	return 3
+}
+
+func TestDecoderNumber(t *testing.T) {
+	t.Parallel()
+
+	v := &testNumbers{}
+	json := `{"id":123456789012345678901234567890,"amount":-0.10,"items":[1E+2, 0, null, -1.5e-7]}`
+	require.NoError(t, Unmarshal([]byte(json), v))
+	assert.Equal(t, Number("123456789012345678901234567890"), v.id, "the literal is kept as is")
+	require.NotNil(t, v.amount)
+	assert.Equal(t, Number("-0.10"), *v.amount)
+	assert.Equal(t, []Number{"1E+2", "0", "", "-1.5e-7"}, v.items)
+
+	v = &testNumbers{}
+	require.NoError(t, Unmarshal([]byte(`{"amount":null}`), v))
+	assert.Nil(t, v.amount)
+
+	var n Number
+	require.NoError(t, NewDecoder(strings.NewReader(` 1.25 `)).DecodeNumber(&n))
+	assert.Equal(t, Number("1.25"), n)
+}
+
+func TestDecoderNumberErrors(t *testing.T) {
+	t.Parallel()
+
+	err := Unmarshal([]byte(`{"id":"12"}`), &testNumbers{})
+	var decErr *DecodeError
+	require.ErrorAs(t, err, &decErr)
+	assert.ErrorAs(t, err, new(InvalidUnmarshalError))
+	assert.Equal(t, "*gojay.Number", decErr.Expected)
+	assert.Equal(t, "string", decErr.Found)
+
+	err = Unmarshal([]byte(`{"id":1.}`), &testNumbers{})
+	require.ErrorAs(t, err, new(InvalidJSONError))
+	err = Unmarshal([]byte(`{"id":-}`), &testNumbers{})
+	require.ErrorAs(t, err, new(InvalidJSONError))
+}
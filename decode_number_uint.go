@@ -11,6 +11,7 @@ func (dec *Decoder) DecodeUint8(v *uint8) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	return dec.decodeUint8(v)
 }
 
@@ -99,8 +100,9 @@ func (dec *Decoder) decodeUint8Null(v **uint8) error {
 func (dec *Decoder) getUint8() (uint8, error) {
 	end := dec.cursor
 	start := dec.cursor
+	dec.tokenStart = start
 	// look for following numbers
-	for j := dec.cursor + 1; j < dec.length || dec.read(); j++ {
+	for j := dec.cursor + 1; j < dec.length || dec.readToken(); j++ {
 		switch dec.data[j] {
 		case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 			end = j
@@ -124,6 +126,7 @@ func (dec *Decoder) DecodeUint16(v *uint16) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	return dec.decodeUint16(v)
 }
 
@@ -212,8 +215,9 @@ func (dec *Decoder) decodeUint16Null(v **uint16) error {
 func (dec *Decoder) getUint16() (uint16, error) {
 	end := dec.cursor
 	start := dec.cursor
+	dec.tokenStart = start
 	// look for following numbers
-	for j := dec.cursor + 1; j < dec.length || dec.read(); j++ {
+	for j := dec.cursor + 1; j < dec.length || dec.readToken(); j++ {
 		switch dec.data[j] {
 		case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 			end = j
@@ -237,6 +241,7 @@ func (dec *Decoder) DecodeUint32(v *uint32) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	return dec.decodeUint32(v)
 }
 
@@ -325,8 +330,9 @@ func (dec *Decoder) decodeUint32Null(v **uint32) error {
 func (dec *Decoder) getUint32() (uint32, error) {
 	end := dec.cursor
 	start := dec.cursor
+	dec.tokenStart = start
 	// look for following numbers
-	for j := dec.cursor + 1; j < dec.length || dec.read(); j++ {
+	for j := dec.cursor + 1; j < dec.length || dec.readToken(); j++ {
 		switch dec.data[j] {
 		case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 			end = j
@@ -350,6 +356,7 @@ func (dec *Decoder) DecodeUint64(v *uint64) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	return dec.decodeUint64(v)
 }
 
@@ -438,8 +445,9 @@ func (dec *Decoder) decodeUint64Null(v **uint64) error {
 func (dec *Decoder) getUint64() (uint64, error) {
 	end := dec.cursor
 	start := dec.cursor
+	dec.tokenStart = start
 	// look for following numbers
-	for j := dec.cursor + 1; j < dec.length || dec.read(); j++ {
+	for j := dec.cursor + 1; j < dec.length || dec.readToken(); j++ {
 		switch dec.data[j] {
 		case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 			end = j
@@ -45,14 +45,14 @@ func TestDecoderUint64(t *testing.T) {
 			json:           "nxll",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-skip-data-err",
 			json:           "trua",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-big",
@@ -105,14 +105,14 @@ func TestDecoderUint64(t *testing.T) {
 			json:           "-83zez4",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "invalid-type",
 			json:           `"string"`,
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 	}
 	for _, testCase := range testCases {
@@ -125,10 +125,10 @@ func TestDecoderUint64(t *testing.T) {
 			if testCase.err {
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(
+					assert.ErrorAs(
 						t,
-						testCase.errType,
 						err,
+						testCase.errType,
 						"err should be of type %s",
 						reflect.TypeOf(err).String(),
 					)
@@ -177,7 +177,7 @@ func TestDecoderUint64(t *testing.T) {
 		defer dec.Release()
 		err := dec.DecodeUint64(&v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 }
 
@@ -219,14 +219,14 @@ func TestDecoderUint64Null(t *testing.T) {
 			json:           "nxll",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-skip-data-err",
 			json:           "trua",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-big",
@@ -279,14 +279,14 @@ func TestDecoderUint64Null(t *testing.T) {
 			json:           "-83zez4",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "invalid-type",
 			json:           `"string"`,
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 	}
 	for _, testCase := range testCases {
@@ -299,10 +299,10 @@ func TestDecoderUint64Null(t *testing.T) {
 			if testCase.err {
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(
+					assert.ErrorAs(
 						t,
-						testCase.errType,
 						err,
+						testCase.errType,
 						"err should be of type %s",
 						reflect.TypeOf(err).String(),
 					)
@@ -329,7 +329,7 @@ func TestDecoderUint64Null(t *testing.T) {
 		v := new(uint64)
 		err := Unmarshal([]byte(``), &v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 	t.Run("decoder-api-invalid-json2", func(t *testing.T) {
 		t.Parallel()
@@ -338,7 +338,7 @@ func TestDecoderUint64Null(t *testing.T) {
 		dec := NewDecoder(strings.NewReader(``))
 		err := dec.Uint64Null(&v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 }
 
@@ -378,14 +378,14 @@ func TestDecoderUint32(t *testing.T) {
 			json:           "nxll",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-skip-data-err",
 			json:           "trua",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-negative2",
@@ -432,28 +432,28 @@ func TestDecoderUint32(t *testing.T) {
 			json:           "83zez4",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error",
 			json:           "-83zez4",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "invalid-type",
 			json:           `"string"`,
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "invalid-json",
 			json:           `123invalid`,
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 	}
 	for _, testCase := range testCases {
@@ -466,10 +466,10 @@ func TestDecoderUint32(t *testing.T) {
 			if testCase.err {
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(
+					assert.ErrorAs(
 						t,
-						testCase.errType,
 						err,
+						testCase.errType,
 						"err should be of type %s",
 						reflect.TypeOf(err).String(),
 					)
@@ -518,7 +518,7 @@ func TestDecoderUint32(t *testing.T) {
 		defer dec.Release()
 		err := dec.DecodeUint32(&v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 }
 
@@ -560,14 +560,14 @@ func TestDecoderUint32Null(t *testing.T) {
 			json:           "nxll",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-skip-data-err",
 			json:           "trua",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-negative2",
@@ -614,28 +614,28 @@ func TestDecoderUint32Null(t *testing.T) {
 			json:           "83zez4",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error",
 			json:           "-83zez4",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "invalid-type",
 			json:           `"string"`,
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "invalid-json",
 			json:           `123invalid`,
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 	}
 	for _, testCase := range testCases {
@@ -648,10 +648,10 @@ func TestDecoderUint32Null(t *testing.T) {
 			if testCase.err {
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(
+					assert.ErrorAs(
 						t,
-						testCase.errType,
 						err,
+						testCase.errType,
 						"err should be of type %s",
 						reflect.TypeOf(err).String(),
 					)
@@ -678,7 +678,7 @@ func TestDecoderUint32Null(t *testing.T) {
 		v := new(uint32)
 		err := Unmarshal([]byte(``), &v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 	t.Run("decoder-api-invalid-json2", func(t *testing.T) {
 		t.Parallel()
@@ -687,7 +687,7 @@ func TestDecoderUint32Null(t *testing.T) {
 		dec := NewDecoder(strings.NewReader(``))
 		err := dec.Uint32Null(&v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 }
 
@@ -727,28 +727,28 @@ func TestDecoderUint16(t *testing.T) {
 			json:           "nxll",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-skip-data-err",
 			json:           "trua",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-skip-data-err",
 			json:           "trua",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-overflow",
 			json:           "335346564",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "basic-negative2",
@@ -801,28 +801,28 @@ func TestDecoderUint16(t *testing.T) {
 			json:           "83zez4",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error",
 			json:           "-83zez4",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "invalid-type",
 			json:           `"string"`,
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "invalid-json",
 			json:           `123invalid`,
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 	}
 	for _, testCase := range testCases {
@@ -835,10 +835,10 @@ func TestDecoderUint16(t *testing.T) {
 			if testCase.err {
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(
+					assert.ErrorAs(
 						t,
-						testCase.errType,
 						err,
+						testCase.errType,
 						"err should be of type %s",
 						reflect.TypeOf(err).String(),
 					)
@@ -897,7 +897,7 @@ func TestDecoderUint16(t *testing.T) {
 		defer dec.Release()
 		err := dec.DecodeUint16(&v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 }
 
@@ -939,28 +939,28 @@ func TestDecoderUint16Null(t *testing.T) {
 			json:           "nxll",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-skip-data-err",
 			json:           "trua",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-skip-data-err",
 			json:           "trua",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-overflow",
 			json:           "335346564",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "basic-negative2",
@@ -1013,28 +1013,28 @@ func TestDecoderUint16Null(t *testing.T) {
 			json:           "83zez4",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error",
 			json:           "-83zez4",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "invalid-type",
 			json:           `"string"`,
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "invalid-json",
 			json:           `123invalid`,
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 	}
 	for _, testCase := range testCases {
@@ -1047,10 +1047,10 @@ func TestDecoderUint16Null(t *testing.T) {
 			if testCase.err {
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(
+					assert.ErrorAs(
 						t,
-						testCase.errType,
 						err,
+						testCase.errType,
 						"err should be of type %s", reflect.TypeOf(err).String(),
 					)
 				}
@@ -1076,7 +1076,7 @@ func TestDecoderUint16Null(t *testing.T) {
 		v := new(uint16)
 		err := Unmarshal([]byte(``), &v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 	t.Run("decoder-api-invalid-json2", func(t *testing.T) {
 		t.Parallel()
@@ -1085,7 +1085,7 @@ func TestDecoderUint16Null(t *testing.T) {
 		dec := NewDecoder(strings.NewReader(``))
 		err := dec.Uint16Null(&v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 }
 
@@ -1125,14 +1125,14 @@ func TestDecoderUint8(t *testing.T) {
 			json:           "nxll",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-skip-data-err",
 			json:           "trua",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-negative2",
@@ -1150,14 +1150,14 @@ func TestDecoderUint8(t *testing.T) {
 			json:           "256",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "basic-overflow",
 			json:           "274",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "basic-big-overflow",
@@ -1187,28 +1187,28 @@ func TestDecoderUint8(t *testing.T) {
 			json:           "83zez4",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error",
 			json:           "-83zez4",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "invalid-type",
 			json:           `"string"`,
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "invalid-json",
 			json:           `123invalid`,
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 	}
 	for _, testCase := range testCases {
@@ -1221,10 +1221,10 @@ func TestDecoderUint8(t *testing.T) {
 			if testCase.err {
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(
+					assert.ErrorAs(
 						t,
-						testCase.errType,
 						err,
+						testCase.errType,
 						"err should be of type %s",
 						reflect.TypeOf(err).String(),
 					)
@@ -1283,7 +1283,7 @@ func TestDecoderUint8(t *testing.T) {
 		defer dec.Release()
 		err := dec.DecodeUint8(&v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 }
 
@@ -1325,14 +1325,14 @@ func TestDecoderUint8Null(t *testing.T) {
 			json:           "nxll",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-skip-data-err",
 			json:           "trua",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-negative2",
@@ -1350,14 +1350,14 @@ func TestDecoderUint8Null(t *testing.T) {
 			json:           "256",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "basic-overflow",
 			json:           "274",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "basic-big-overflow",
@@ -1387,28 +1387,28 @@ func TestDecoderUint8Null(t *testing.T) {
 			json:           "83zez4",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "error",
 			json:           "-83zez4",
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "invalid-type",
 			json:           `"string"`,
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "invalid-json",
 			json:           `123invalid`,
 			expectedResult: 0,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 	}
 	for _, testCase := range testCases {
@@ -1421,10 +1421,10 @@ func TestDecoderUint8Null(t *testing.T) {
 			if testCase.err {
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(
+					assert.ErrorAs(
 						t,
-						testCase.errType,
 						err,
+						testCase.errType,
 						"err should be of type %s", reflect.TypeOf(err).String(),
 					)
 				}
@@ -1450,7 +1450,7 @@ func TestDecoderUint8Null(t *testing.T) {
 		v := new(uint8)
 		err := Unmarshal([]byte(``), &v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 	t.Run("decoder-api-invalid-json2", func(t *testing.T) {
 		t.Parallel()
@@ -1459,6 +1459,6 @@ func TestDecoderUint8Null(t *testing.T) {
 		dec := NewDecoder(strings.NewReader(``))
 		err := dec.Uint8Null(&v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 }
@@ -2,6 +2,7 @@ package gojay
 
 import (
 	"reflect"
+	"strings"
 	"unsafe"
 )
 
@@ -14,35 +15,62 @@ func (dec *Decoder) DecodeObject(j UnmarshalerJSONObject) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	_, err := dec.decodeObject(j)
 	return err
 }
 
-//nolint:funlen,gocognit,cyclop
 func (dec *Decoder) decodeObject(j UnmarshalerJSONObject) (int, error) {
+	// remember the path and nesting depth in case of nested objects,
+	// they are restored without a defer which is not open-coded in the key loop with its many returns
+	depth := len(dec.path)
+	nesting := dec.depth
+	end, err := dec.decodeObjectKeys(j, depth, nesting)
+	dec.path = dec.path[:depth]
+	dec.depth = nesting
+	return end, err
+}
+
+//nolint:funlen,gocognit,cyclop
+func (dec *Decoder) decodeObjectKeys(j UnmarshalerJSONObject, depth, nesting int) (int, error) {
 	keys := j.NKeys()
+	// keys already found in the object, only tracked if duplicates are not left to the last one
+	var seen map[string]struct{}
 	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
 		switch dec.data[dec.cursor] {
 		case ' ', '\n', '\t', '\r', ',':
 		case '{':
+			dec.depth++
+			if err := dec.checkDepth(dec.depth, dec.cursor); err != nil {
+				return 0, err
+			}
 			dec.cursor++
-			// if keys is zero we will parse all keys
+			// keys are counted per object, a Decoder may decode several objects in a row
+			dec.keysDone = 0
+			// if keys is zero we will parse all keys, in strict mode or if duplicates fail all keys must be checked
 			// we run two loops for micro optimization
 			//nolint:nestif
-			if keys == 0 {
+			if keys == 0 || dec.strict || dec.duplicateKeys == DuplicateKeyFails {
 				for dec.cursor < dec.length || dec.read() {
-					k, done, err := dec.nextKey()
+					k, done, err := dec.nextKey(depth)
 					if err != nil {
 						return 0, err
 					} else if done {
 						return dec.cursor, nil
 					}
+					if dec.duplicateKeys != DuplicateKeyLastWins {
+						if skipped, err := dec.skipDuplicateKey(&seen, k); err != nil {
+							return 0, err
+						} else if skipped {
+							continue
+						}
+					}
 					err = j.UnmarshalJSONObject(dec, k)
 					if err != nil {
 						dec.err = err
 						return 0, err
 					} else if dec.called&1 == 0 {
-						err := dec.skipData()
+						err := dec.skipUnknownKey(k)
 						if err != nil {
 							return 0, err
 						}
@@ -53,18 +81,25 @@ func (dec *Decoder) decodeObject(j UnmarshalerJSONObject) (int, error) {
 				}
 			} else {
 				for (dec.cursor < dec.length || dec.read()) && dec.keysDone < keys {
-					k, done, err := dec.nextKey()
+					k, done, err := dec.nextKey(depth)
 					if err != nil {
 						return 0, err
 					} else if done {
 						return dec.cursor, nil
 					}
+					if dec.duplicateKeys != DuplicateKeyLastWins {
+						if skipped, err := dec.skipDuplicateKey(&seen, k); err != nil {
+							return 0, err
+						} else if skipped {
+							continue
+						}
+					}
 					err = j.UnmarshalJSONObject(dec, k)
 					if err != nil {
 						dec.err = err
 						return 0, err
 					} else if dec.called&1 == 0 {
-						err := dec.skipData()
+						err := dec.skipUnknownKey(k)
 						if err != nil {
 							return 0, err
 						}
@@ -78,6 +113,8 @@ func (dec *Decoder) decodeObject(j UnmarshalerJSONObject) (int, error) {
 			// in that case, we make sure cursor goes to the end of object, but we skip
 			// unmarshalling
 			if dec.child&1 != 0 {
+				// the rest of the object is skipped at the depth of its parent
+				dec.depth = nesting
 				end, err := dec.skipObject()
 				dec.cursor = end
 				return dec.cursor, err
@@ -103,8 +140,18 @@ func (dec *Decoder) decodeObject(j UnmarshalerJSONObject) (int, error) {
 	return 0, dec.raiseInvalidJSONErr(dec.cursor)
 }
 
-//nolint:funlen,gocognit,cyclop
 func (dec *Decoder) decodeObjectNull(v any) (int, error) {
+	// remember the path and nesting depth in case of nested objects
+	depth := len(dec.path)
+	nesting := dec.depth
+	end, err := dec.decodeObjectNullKeys(v, depth, nesting)
+	dec.path = dec.path[:depth]
+	dec.depth = nesting
+	return end, err
+}
+
+//nolint:funlen,gocognit,cyclop
+func (dec *Decoder) decodeObjectNullKeys(v any, depth, nesting int) (int, error) {
 	// make sure the value is a pointer
 	vv := reflect.ValueOf(v)
 	vvt := vv.Type()
@@ -112,10 +159,16 @@ func (dec *Decoder) decodeObjectNull(v any) (int, error) {
 		dec.err = ErrUnmarshalPtrExpected
 		return 0, dec.err
 	}
+	// keys already found in the object, only tracked if duplicates are not left to the last one
+	var seen map[string]struct{}
 	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
 		switch dec.data[dec.cursor] {
 		case ' ', '\n', '\t', '\r', ',':
 		case '{':
+			dec.depth++
+			if err := dec.checkDepth(dec.depth, dec.cursor); err != nil {
+				return 0, err
+			}
 			elt := vv.Elem()
 			n := reflect.New(elt.Type().Elem())
 			elt.Set(n)
@@ -127,23 +180,32 @@ func (dec *Decoder) decodeObjectNull(v any) (int, error) {
 			}
 			keys := j.NKeys()
 			dec.cursor++
-			// if keys is zero we will parse all keys
+			// keys are counted per object, a Decoder may decode several objects in a row
+			dec.keysDone = 0
+			// if keys is zero we will parse all keys, in strict mode or if duplicates fail all keys must be checked
 			// we run two loops for micro optimization
 			//nolint:nestif
-			if keys == 0 {
+			if keys == 0 || dec.strict || dec.duplicateKeys == DuplicateKeyFails {
 				for dec.cursor < dec.length || dec.read() {
-					k, done, err := dec.nextKey()
+					k, done, err := dec.nextKey(depth)
 					if err != nil {
 						return 0, err
 					} else if done {
 						return dec.cursor, nil
 					}
+					if dec.duplicateKeys != DuplicateKeyLastWins {
+						if skipped, err := dec.skipDuplicateKey(&seen, k); err != nil {
+							return 0, err
+						} else if skipped {
+							continue
+						}
+					}
 					err = j.UnmarshalJSONObject(dec, k)
 					if err != nil {
 						dec.err = err
 						return 0, err
 					} else if dec.called&1 == 0 {
-						err := dec.skipData()
+						err := dec.skipUnknownKey(k)
 						if err != nil {
 							return 0, err
 						}
@@ -154,18 +216,25 @@ func (dec *Decoder) decodeObjectNull(v any) (int, error) {
 				}
 			} else {
 				for (dec.cursor < dec.length || dec.read()) && dec.keysDone < keys {
-					k, done, err := dec.nextKey()
+					k, done, err := dec.nextKey(depth)
 					if err != nil {
 						return 0, err
 					} else if done {
 						return dec.cursor, nil
 					}
+					if dec.duplicateKeys != DuplicateKeyLastWins {
+						if skipped, err := dec.skipDuplicateKey(&seen, k); err != nil {
+							return 0, err
+						} else if skipped {
+							continue
+						}
+					}
 					err = j.UnmarshalJSONObject(dec, k)
 					if err != nil {
 						dec.err = err
 						return 0, err
 					} else if dec.called&1 == 0 {
-						err := dec.skipData()
+						err := dec.skipUnknownKey(k)
 						if err != nil {
 							return 0, err
 						}
@@ -179,6 +248,8 @@ func (dec *Decoder) decodeObjectNull(v any) (int, error) {
 			// in that case, we make sure cursor goes to the end of object, but we skip
 			// unmarshalling
 			if dec.child&1 != 0 {
+				// the rest of the object is skipped at the depth of its parent
+				dec.depth = nesting
 				end, err := dec.skipObject()
 				dec.cursor = end
 				return dec.cursor, err
@@ -208,17 +279,31 @@ func (dec *Decoder) decodeObjectNull(v any) (int, error) {
 func (dec *Decoder) skipObject() (int, error) {
 	objectsOpen := 1
 	objectsClosed := 0
+	// nesting counts objects and arrays open to enforce the maximum depth
+	nesting := 1
+	if err := dec.checkDepth(dec.depth+nesting, dec.cursor-1); err != nil {
+		return 0, err
+	}
 	for j := dec.cursor; j < dec.length || dec.read(); j++ {
 		switch dec.data[j] {
 		case '}':
+			nesting--
 			objectsClosed++
 			// everything is closed return
 			if objectsOpen == objectsClosed {
 				// add char to object data
 				return j + 1, nil
 			}
-		case '{':
-			objectsOpen++
+		case '{', '[':
+			nesting++
+			if err := dec.checkDepth(dec.depth+nesting, j); err != nil {
+				return 0, err
+			}
+			if dec.data[j] == '{' {
+				objectsOpen++
+			}
+		case ']':
+			nesting--
 		case '"':
 			j++
 			var isInEscapeSeq bool
@@ -257,16 +342,21 @@ func (dec *Decoder) skipObject() (int, error) {
 	return 0, dec.raiseInvalidJSONErr(dec.cursor)
 }
 
-func (dec *Decoder) nextKey() (string, bool, error) {
+// nextKey reads the next key of an object and sets it as the step of the path at depth.
+func (dec *Decoder) nextKey(depth int) (string, bool, error) {
 	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
 		switch dec.data[dec.cursor] {
 		case ' ', '\n', '\t', '\r', ',':
 			continue
 		case '"':
 			dec.cursor++
-			start, end, err := dec.getStringNoEscape()
+			// keys are unescaped like string values
+			start, end, err := dec.getString()
 			if err != nil {
 				return "", false, err
+			} else if dec.err != nil {
+				// a value which didn't fit its receiver stops decoding at the next key
+				return "", false, dec.err
 			}
 			var found byte
 			for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
@@ -277,19 +367,20 @@ func (dec *Decoder) nextKey() (string, bool, error) {
 			}
 			if found&1 != 0 {
 				dec.cursor++
+				dec.setKeyPath(depth, start, end-1)
 				d := dec.data[start : end-1]
 				return *(*string)(unsafe.Pointer(&d)), false, nil
 			}
-			return "", false, dec.raiseInvalidJSONErr(dec.cursor)
+			return "", false, dec.raiseUnexpectedErr(dec.cursor, "':'")
 		case '}':
 			dec.cursor++
 			return "", true, nil
 		default:
 			// can't unmarshall to struct
-			return "", false, dec.raiseInvalidJSONErr(dec.cursor)
+			return "", false, dec.raiseUnexpectedErr(dec.cursor, "string key or '}'")
 		}
 	}
-	return "", false, dec.raiseInvalidJSONErr(dec.cursor)
+	return "", false, dec.raiseUnexpectedErr(dec.cursor, "string key or '}'")
 }
 
 //nolint:funlen
@@ -348,6 +439,53 @@ func (dec *Decoder) skipData() error {
 	return dec.raiseInvalidJSONErr(dec.cursor)
 }
 
+// skipUnknownKey skips the value of the key k which was not decoded by UnmarshalJSONObject,
+// in strict mode it fails with an UnknownKeyError unless the key is allowed.
+func (dec *Decoder) skipUnknownKey(k string) error {
+	if dec.strict && (dec.allowUnknownKey == nil || !dec.allowUnknownKey(pathString(dec.path[:len(dec.path)-1], dec.data), k)) {
+		dec.err = dec.makeDecodeErr(
+			&UnknownKeyError{Key: strings.Clone(k), Path: dec.jsonPath()},
+			dec.cursor,
+			"",
+			dec.foundKind(dec.cursor),
+		)
+		return dec.err
+	}
+	return dec.skipData()
+}
+
+// skipDuplicateKey applies the duplicate key policy of the Decoder to the key k of an object,
+// seen being the keys found before in the object.
+// It returns true if the value was skipped as the first one wins.
+func (dec *Decoder) skipDuplicateKey(seen *map[string]struct{}, k string) (bool, error) {
+	if *seen == nil {
+		*seen = make(map[string]struct{})
+	}
+	if _, ok := (*seen)[k]; !ok {
+		(*seen)[k] = struct{}{}
+		return false, nil
+	}
+	if err := dec.duplicateKeyErr(k); err != nil {
+		return false, err
+	}
+	return true, dec.skipData()
+}
+
+// duplicateKeyErr returns a DuplicateKeyError for the key k found twice
+// if the duplicate key policy of the Decoder is DuplicateKeyFails.
+func (dec *Decoder) duplicateKeyErr(k string) error {
+	if dec.duplicateKeys != DuplicateKeyFails {
+		return nil
+	}
+	dec.err = dec.makeDecodeErr(
+		&DuplicateKeyError{Key: strings.Clone(k), Path: dec.jsonPath()},
+		dec.cursor,
+		"",
+		dec.foundKind(dec.cursor),
+	)
+	return dec.err
+}
+
 // DecodeObjectFunc is a func type implementing UnmarshalerJSONObject.
 // Use it to cast a `func(*Decoder, k string) error` to Unmarshal an object on the fly.
 type DecodeObjectFunc func(*Decoder, string) error
@@ -1,6 +1,7 @@
 package gojay
 
 import (
+	"errors"
 	"io"
 	"reflect"
 	"strings"
@@ -163,28 +164,28 @@ func TestDecodeObjectBasic(t *testing.T) {
 			json:           `1`,
 			expectedResult: testObject{},
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "basic-err-invalid-json",
 			json:           `hello`,
 			expectedResult: testObject{},
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-err-invalid-json",
 			json:           `nall`,
 			expectedResult: testObject{},
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-err-invalid-type",
 			json:           ``,
 			expectedResult: testObject{},
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name: "basic-err",
@@ -546,7 +547,7 @@ func TestDecodeObjectBasic(t *testing.T) {
 				t.Log(err)
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(t, testCase.errType, err, "err should be of the given type")
+					assert.ErrorAs(t, err, testCase.errType, "err should be of the given type")
 				}
 				return
 			}
@@ -608,28 +609,28 @@ func TestDecodeObjectBasic0Keys(t *testing.T) {
 			json:           `1`,
 			expectedResult: testObject0Keys{},
 			err:            true,
-			errType:        InvalidUnmarshalError(""),
+			errType:        new(InvalidUnmarshalError),
 		},
 		{
 			name:           "basic-err-invalid-json",
 			json:           `hello`,
 			expectedResult: testObject0Keys{},
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-err-invalid-json",
 			json:           `nall`,
 			expectedResult: testObject0Keys{},
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "basic-err-invalid-type",
 			json:           ``,
 			expectedResult: testObject0Keys{},
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name: "basic-err",
@@ -907,7 +908,7 @@ func TestDecodeObjectBasic0Keys(t *testing.T) {
 				t.Log(err)
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(t, testCase.errType, err, "err should be of the given type")
+					assert.ErrorAs(t, err, testCase.errType, "err should be of the given type")
 				}
 				return
 			}
@@ -925,7 +926,7 @@ func TestDecodeObjectBasic0Keys(t *testing.T) {
 				t.Log(err)
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(t, testCase.errType, err, "err should be of the given type")
+					assert.ErrorAs(t, err, testCase.errType, "err should be of the given type")
 				}
 				return
 			}
@@ -1114,7 +1115,7 @@ func TestDecodeObjectNull(t *testing.T) {
 				}),
 			)
 			require.Error(t, err)
-			assert.IsType(t, InvalidUnmarshalError(""), err)
+			assert.ErrorAs(t, err, new(InvalidUnmarshalError))
 		},
 	)
 	t.Run(
@@ -1130,7 +1131,7 @@ func TestDecodeObjectNull(t *testing.T) {
 				}),
 			)
 			require.Error(t, err)
-			assert.IsType(t, InvalidUnmarshalError(""), err)
+			assert.ErrorAs(t, err, new(InvalidUnmarshalError))
 		},
 	)
 	t.Run(
@@ -1146,7 +1147,7 @@ func TestDecodeObjectNull(t *testing.T) {
 				}),
 			)
 			require.Error(t, err)
-			assert.IsType(t, InvalidUnmarshalError(""), err)
+			assert.ErrorAs(t, err, new(InvalidUnmarshalError))
 		},
 	)
 	t.Run(
@@ -1162,7 +1163,7 @@ func TestDecodeObjectNull(t *testing.T) {
 				}),
 			)
 			require.Error(t, err)
-			assert.IsType(t, InvalidJSONError(""), err)
+			assert.ErrorAs(t, err, new(InvalidJSONError))
 		},
 	)
 	t.Run(
@@ -1178,7 +1179,7 @@ func TestDecodeObjectNull(t *testing.T) {
 				}),
 			)
 			require.Error(t, err)
-			assert.IsType(t, InvalidUnmarshalError(""), err)
+			assert.ErrorAs(t, err, new(InvalidUnmarshalError))
 		},
 	)
 	t.Run(
@@ -1191,7 +1192,7 @@ func TestDecodeObjectNull(t *testing.T) {
 				return dec.ObjectNull(&o.SubObject)
 			}))
 			require.Error(t, err)
-			assert.IsType(t, InvalidJSONError(""), err)
+			assert.ErrorAs(t, err, new(InvalidJSONError))
 		},
 	)
 	t.Run(
@@ -1204,7 +1205,7 @@ func TestDecodeObjectNull(t *testing.T) {
 				return dec.ObjectNull(&o.SubObject)
 			}))
 			require.Error(t, err)
-			assert.IsType(t, InvalidJSONError(""), err)
+			assert.ErrorAs(t, err, new(InvalidJSONError))
 		},
 	)
 	t.Run(
@@ -1217,7 +1218,7 @@ func TestDecodeObjectNull(t *testing.T) {
 				return dec.ObjectNull(&o.SubObject)
 			}))
 			require.Error(t, err)
-			assert.IsType(t, InvalidJSONError(""), err)
+			assert.ErrorAs(t, err, new(InvalidJSONError))
 		},
 	)
 	t.Run(
@@ -1230,7 +1231,7 @@ func TestDecodeObjectNull(t *testing.T) {
 				return dec.ArrayNull(&o)
 			}))
 			require.Error(t, err)
-			assert.IsType(t, InvalidJSONError(""), err)
+			assert.ErrorAs(t, err, new(InvalidJSONError))
 		},
 	)
 	t.Run(
@@ -1243,7 +1244,7 @@ func TestDecodeObjectNull(t *testing.T) {
 				return dec.ObjectNull(&strPtr)
 			}))
 			require.Error(t, err)
-			assert.IsType(t, InvalidJSONError(""), err)
+			assert.ErrorAs(t, err, new(InvalidJSONError))
 		},
 	)
 	t.Run(
@@ -1256,7 +1257,7 @@ func TestDecodeObjectNull(t *testing.T) {
 				return dec.ObjectNull(&o)
 			}))
 			require.Error(t, err)
-			assert.IsType(t, InvalidJSONError(""), err)
+			assert.ErrorAs(t, err, new(InvalidJSONError))
 		},
 	)
 	t.Run(
@@ -1267,7 +1268,7 @@ func TestDecodeObjectNull(t *testing.T) {
 			o := &ObjectNull{}
 			err := UnmarshalJSONObject([]byte(`{"subobject": a`), o)
 			require.Error(t, err)
-			assert.IsType(t, InvalidJSONError(""), err)
+			assert.ErrorAs(t, err, new(InvalidJSONError))
 		},
 	)
 	t.Run(
@@ -1278,7 +1279,7 @@ func TestDecodeObjectNull(t *testing.T) {
 			o := &ObjectNull{}
 			err := UnmarshalJSONObject([]byte(`{"subobject": na`), o)
 			require.Error(t, err)
-			assert.IsType(t, InvalidJSONError(""), err)
+			assert.ErrorAs(t, err, new(InvalidJSONError))
 		},
 	)
 	t.Run(
@@ -1338,7 +1339,7 @@ func TestDecodeObjectNull(t *testing.T) {
 				return dec.ObjectNull(&o.SubObject)
 			}))
 			require.Error(t, err)
-			assert.IsType(t, InvalidJSONError(""), err)
+			assert.ErrorAs(t, err, new(InvalidJSONError))
 		},
 	)
 	t.Run(
@@ -1355,7 +1356,7 @@ func TestDecodeObjectNull(t *testing.T) {
 				return dec.ObjectNull(&o.SubObject)
 			}))
 			require.Error(t, err)
-			assert.IsType(t, InvalidJSONError(""), err)
+			assert.ErrorAs(t, err, new(InvalidJSONError))
 		},
 	)
 	t.Run(
@@ -1374,7 +1375,7 @@ func TestDecodeObjectNull(t *testing.T) {
 				return dec.ObjectNull(&o.SubObject)
 			}))
 			require.Error(t, err)
-			assert.IsType(t, InvalidJSONError(""), err)
+			assert.ErrorAs(t, err, new(InvalidJSONError))
 		},
 	)
 }
@@ -1455,7 +1456,7 @@ func TestDecodeObjectComplex(t *testing.T) {
 				t.Log(err)
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(t, testCase.errType, err, "err should be of the given type")
+					assert.ErrorAs(t, err, testCase.errType, "err should be of the given type")
 				}
 				return
 			}
@@ -1616,7 +1617,7 @@ func TestDecodeObjComplex(t *testing.T) {
 	result := jsonObjectComplex{}
 	err := UnmarshalJSONObject(jsonComplex, &result)
 	require.Error(t, err, "err should not be as invalid type as been encountered nil")
-	assert.Equal(t, `Cannot unmarshal JSON to type '*gojay.jsonObjectComplex'`, err.Error(), "err should not be as invalid type as been encountered nil")
+	assert.Equal(t, `Cannot unmarshal JSON to type '*gojay.jsonObjectComplex'`, errors.Unwrap(err).Error(), "err should not be as invalid type as been encountered nil")
 	assert.Equal(t, `{"test":"1","test1":2}`, result.Test, "result.Test is not expected value")
 	assert.Equal(t, "\\\\\\\\\n", result.Test2, "result.Test2 is not expected value")
 	assert.Equal(t, 0, result.Test3, "result.test3 is not expected value")
@@ -1676,7 +1677,7 @@ func TestDecoderObjectInvalidJSON(t *testing.T) {
 	dec.length = len(dec.data)
 	err := dec.DecodeObject(&result)
 	require.Error(t, err, "Err must not be nil as JSON is invalid")
-	assert.IsType(t, InvalidJSONError(""), err, "err message must be 'Invalid JSON'")
+	assert.ErrorAs(t, err, new(InvalidJSONError), "err message must be 'Invalid JSON'")
 }
 
 type myMap map[string]string
@@ -1825,7 +1826,7 @@ func TestDecoderObjectDecoderInvalidJSONError(t *testing.T) {
 	dec := NewDecoder(strings.NewReader(`{"err:}`))
 	err := dec.DecodeObject(v)
 	require.Error(t, err, "Err must not be nil as JSON is invalid")
-	assert.IsType(t, InvalidJSONError(""), err, "err message must be 'Invalid JSON'")
+	assert.ErrorAs(t, err, new(InvalidJSONError), "err message must be 'Invalid JSON'")
 }
 
 func TestDecoderObjectDecoderInvalidJSONError2(t *testing.T) {
@@ -1835,7 +1836,7 @@ func TestDecoderObjectDecoderInvalidJSONError2(t *testing.T) {
 	dec := NewDecoder(strings.NewReader(`{"err:}`))
 	err := dec.DecodeObject(v)
 	require.Error(t, err, "Err must not be nil as JSON is invalid")
-	assert.IsType(t, InvalidJSONError(""), err, "err message must be 'Invalid JSON'")
+	assert.ErrorAs(t, err, new(InvalidJSONError), "err message must be 'Invalid JSON'")
 }
 
 func TestDecoderObjectDecoderInvalidJSONError3(t *testing.T) {
@@ -1845,7 +1846,7 @@ func TestDecoderObjectDecoderInvalidJSONError3(t *testing.T) {
 	dec := NewDecoder(strings.NewReader(`{"err":"test}`))
 	err := dec.DecodeObject(v)
 	require.Error(t, err, "Err must not be nil as JSON is invalid")
-	assert.IsType(t, InvalidJSONError(""), err, "err message must be 'Invalid JSON'")
+	assert.ErrorAs(t, err, new(InvalidJSONError), "err message must be 'Invalid JSON'")
 }
 
 func TestDecoderObjectDecoderInvalidJSONError4(t *testing.T) {
@@ -1855,7 +1856,7 @@ func TestDecoderObjectDecoderInvalidJSONError4(t *testing.T) {
 	dec := NewDecoder(strings.NewReader(`hello`))
 	err := dec.DecodeArray(&testArr)
 	require.Error(t, err, "Err must not be nil as JSON is invalid")
-	assert.IsType(t, InvalidJSONError(""), err, "err message must be 'Invalid JSON'")
+	assert.ErrorAs(t, err, new(InvalidJSONError), "err message must be 'Invalid JSON'")
 }
 
 func TestDecoderObjectPoolError(t *testing.T) {
@@ -1911,7 +1912,7 @@ func TestNextKey(t *testing.T) {
 			t.Parallel()
 
 			dec := BorrowDecoder(strings.NewReader(testCase.json))
-			s, _, err := dec.nextKey()
+			s, _, err := dec.nextKey(0)
 			if testCase.err {
 				require.Error(t, err)
 				return
@@ -2028,7 +2029,7 @@ func TestSkipData(t *testing.T) {
 		dec := NewDecoder(strings.NewReader(""))
 		err := dec.skipData()
 		require.Error(t, err, "err should not be nil as data is empty")
-		assert.IsType(t, InvalidJSONError(""), err, "err should of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should of type InvalidJSONError")
 	})
 	t.Run("skip-array-error-invalid-json", func(t *testing.T) {
 		t.Parallel()
@@ -2036,6 +2037,6 @@ func TestSkipData(t *testing.T) {
 		dec := NewDecoder(strings.NewReader(""))
 		_, err := dec.skipArray()
 		require.Error(t, err, "err should not be nil as data is empty")
-		assert.IsType(t, InvalidJSONError(""), err, "err should of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should of type InvalidJSONError")
 	})
 }
@@ -0,0 +1,239 @@
+package gojay
+
+import "fmt"
+
+// DefaultMaxDepth is the maximum nesting depth of objects and arrays accepted by a Decoder
+// unless WithMaxDepth is given.
+const DefaultMaxDepth = 10000
+
+// DecoderOption configures a Decoder,
+// options are given to NewDecoder, BorrowDecoder or the Unmarshal functions.
+type DecoderOption func(dec *Decoder)
+
+// WithMaxDepth sets the maximum nesting depth of objects and arrays the Decoder accepts,
+// values nested deeper fail with a MaxDepthError, whether they are decoded or skipped.
+// If n is 0 or less, the depth is not limited.
+func WithMaxDepth(n int) DecoderOption {
+	return func(dec *Decoder) {
+		dec.maxDepth = n
+	}
+}
+
+const maxDepthErrorMsg = "Maximum nesting depth of %d exceeded"
+
+// MaxDepthError is a type representing an error returned when
+// decoding encounters objects or arrays nested deeper than the maximum depth of the Decoder.
+type MaxDepthError string
+
+func (err MaxDepthError) Error() string {
+	return string(err)
+}
+
+func (dec *Decoder) applyOptions(opts []DecoderOption) {
+	for _, opt := range opts {
+		opt(dec)
+	}
+}
+
+// checkDepth returns a MaxDepthError if depth is over the maximum depth of the Decoder,
+// pos being the position of the object or array opening beyond the limit.
+func (dec *Decoder) checkDepth(depth, pos int) error {
+	if dec.maxDepth <= 0 || depth <= dec.maxDepth {
+		return nil
+	}
+	dec.err = dec.makeDecodeErr(
+		MaxDepthError(fmt.Sprintf(maxDepthErrorMsg, dec.maxDepth)),
+		pos,
+		"",
+		dec.foundChar(pos),
+	)
+	return dec.err
+}
+
+// WithMaxBytes limits the number of bytes the Decoder reads from its io.Reader,
+// reading more fails with a MaxBytesError.
+// If n is 0 or less, the input is not limited.
+func WithMaxBytes(n int) DecoderOption {
+	return func(dec *Decoder) {
+		dec.maxBytes = n
+	}
+}
+
+// WithMaxTokenLength limits the length in bytes of a single string or number,
+// a longer token fails with a MaxTokenLengthError.
+// Numbers are only checked when the Decoder needs to read more of them from its io.Reader.
+// If n is 0 or less, tokens are not limited.
+func WithMaxTokenLength(n int) DecoderOption {
+	return func(dec *Decoder) {
+		dec.maxTokenLength = n
+	}
+}
+
+// WithMaxBufferSize limits the size in bytes of the buffer in which the Decoder reads its io.Reader,
+// when the buffer is full and cannot grow anymore decoding fails with a MaxBufferSizeError.
+// If n is 0 or less, the buffer grows as needed.
+func WithMaxBufferSize(n int) DecoderOption {
+	return func(dec *Decoder) {
+		dec.maxBufferSize = n
+		if n > 0 && len(dec.data) > n && dec.length == 0 {
+			dec.data = make([]byte, n)
+		}
+	}
+}
+
+// DefaultMaxBigExponent is the maximum magnitude of the exponent of a number decoded to a big.Float or a big.Rat
+// unless WithMaxBigExponent is given.
+const DefaultMaxBigExponent = 1000
+
+// WithMaxBigExponent sets the maximum magnitude of the exponent of a number decoded to a big.Float or a big.Rat,
+// like 400 for 1e400 or 1e-400. Parsing a number takes time and memory growing with its exponent,
+// a tiny literal like 1e100000000 would take minutes, a number with a larger exponent fails with an InvalidUnmarshalError.
+// If n is 0 or less, the exponent is not limited, which must only be used with trusted input.
+func WithMaxBigExponent(n int) DecoderOption {
+	return func(dec *Decoder) {
+		dec.maxBigExponent = n
+	}
+}
+
+const (
+	maxBytesErrorMsg       = "Input exceeds the maximum of %d bytes"
+	maxTokenLengthErrorMsg = "Token exceeds the maximum length of %d bytes"
+	maxBufferSizeErrorMsg  = "Buffer exceeds the maximum size of %d bytes"
+)
+
+// MaxBytesError is a type representing an error returned when
+// the input of a Decoder is larger than its maximum number of bytes.
+type MaxBytesError string
+
+func (err MaxBytesError) Error() string {
+	return string(err)
+}
+
+// MaxTokenLengthError is a type representing an error returned when
+// a string or a number is longer than the maximum token length of a Decoder.
+type MaxTokenLengthError string
+
+func (err MaxTokenLengthError) Error() string {
+	return string(err)
+}
+
+// MaxBufferSizeError is a type representing an error returned when
+// the buffer of a Decoder would grow beyond its maximum size.
+type MaxBufferSizeError string
+
+func (err MaxBufferSizeError) Error() string {
+	return string(err)
+}
+
+// raiseReadErr stops reading the input with a limit error located at pos.
+func (dec *Decoder) raiseReadErr(err error, pos int) {
+	dec.readErr = dec.makeDecodeErr(err, pos, "", dec.foundChar(pos))
+	dec.err = dec.readErr
+}
+
+// checkTokenLength returns a MaxTokenLengthError if the token
+// from tokenStart to end (excluded) is longer than the maximum token length.
+func (dec *Decoder) checkTokenLength(end int) error {
+	if dec.maxTokenLength <= 0 || end-dec.tokenStart <= dec.maxTokenLength {
+		return nil
+	}
+	dec.raiseReadErr(MaxTokenLengthError(fmt.Sprintf(maxTokenLengthErrorMsg, dec.maxTokenLength)), dec.tokenStart)
+	return dec.err
+}
+
+// WithBufferReuse makes a Decoder reading from an io.Reader recycle its buffer in place
+// when starting to decode a new top level value, instead of releasing the consumed data to the garbage collector.
+//
+// It avoids allocating when decoding a long stream of values, but strings decoded without copy,
+// including the keys given to UnmarshalJSONObject, are only valid until the next top level value is decoded.
+// Copy them, for example with strings.Clone, if they must outlive it.
+func WithBufferReuse() DecoderOption {
+	return func(dec *Decoder) {
+		dec.reuseBuffer = true
+	}
+}
+
+// WithStrict makes the Decoder fail with an UnknownKeyError when UnmarshalJSONObject
+// does not decode a key, instead of skipping its value.
+func WithStrict() DecoderOption {
+	return func(dec *Decoder) {
+		dec.strict = true
+	}
+}
+
+// WithStrictAllow is WithStrict with an allow-list, keys for which allow returns true are skipped.
+// allow receives the JSON path of the object, like $.items[3], and the key.
+func WithStrictAllow(allow func(path, key string) bool) DecoderOption {
+	return func(dec *Decoder) {
+		dec.strict = true
+		dec.allowUnknownKey = allow
+	}
+}
+
+// UnknownKeyError is the error returned in strict mode when a key of an object is not decoded.
+type UnknownKeyError struct {
+	// Key is the unknown key.
+	Key string
+	// Path is the JSON path of the key, like $.items[3].price.
+	Path string
+}
+
+func (err *UnknownKeyError) Error() string {
+	return fmt.Sprintf("Unknown key %q at %s", err.Key, err.Path)
+}
+
+// DuplicateKeyPolicy defines how a Decoder handles a key found several times in the same object,
+// keys being compared once unescaped.
+type DuplicateKeyPolicy int
+
+const (
+	// DuplicateKeyLastWins decodes every occurrence of the key, the last one wins. It is the default.
+	DuplicateKeyLastWins DuplicateKeyPolicy = iota
+	// DuplicateKeyFirstWins decodes the first occurrence of the key and skips the next ones.
+	DuplicateKeyFirstWins
+	// DuplicateKeyFails fails with a DuplicateKeyError on the second occurrence of the key.
+	DuplicateKeyFails
+)
+
+// DuplicateKeyError is the cause of the DecodeError returned
+// when a key is found twice in an object with the DuplicateKeyFails policy.
+type DuplicateKeyError struct {
+	// Key is the duplicate key.
+	Key string
+	// Path is the JSON path of the key, like $.items[3].price.
+	Path string
+}
+
+func (err *DuplicateKeyError) Error() string {
+	return fmt.Sprintf("Duplicate key %q at %s", err.Key, err.Path)
+}
+
+// WithDuplicateKeyPolicy sets how the Decoder handles a key found several times in the same object,
+// including objects decoded through DecodeObjectFunc and maps decoded to an any.
+func WithDuplicateKeyPolicy(p DuplicateKeyPolicy) DecoderOption {
+	return func(dec *Decoder) {
+		dec.duplicateKeys = p
+	}
+}
+
+// UTF8Policy defines how a Decoder handles strings which are not valid UTF-8.
+type UTF8Policy int
+
+const (
+	// UTF8Unchecked keeps the bytes of strings as they are in the input, unpaired surrogate escape
+	// sequences are replaced with U+FFFD. It is the default.
+	UTF8Unchecked UTF8Policy = iota
+	// UTF8Replace replaces each invalid byte and each unpaired surrogate escape sequence with U+FFFD,
+	// like encoding/json.
+	UTF8Replace
+	// UTF8Reject fails with an InvalidUTF8Error on an invalid byte or an unpaired surrogate escape sequence.
+	UTF8Reject
+)
+
+// WithUTF8Policy sets how the Decoder handles strings which are not valid UTF-8,
+// keys and strings decoded without escaping included.
+func WithUTF8Policy(p UTF8Policy) DecoderOption {
+	return func(dec *Decoder) {
+		dec.utf8Policy = p
+	}
+}
@@ -0,0 +1,584 @@
+package gojay
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testNestedArray struct {
+	inner *testNestedArray
+}
+
+func (t *testNestedArray) UnmarshalJSONArray(dec *Decoder) error {
+	t.inner = &testNestedArray{}
+	return dec.Array(t.inner)
+}
+
+type testNestedObject struct {
+	inner    *testNestedObject
+	embedded EmbeddedJSON
+}
+
+func (t *testNestedObject) UnmarshalJSONObject(dec *Decoder, k string) error {
+	switch k {
+	case "inner":
+		t.inner = &testNestedObject{}
+		return dec.Object(t.inner)
+	case "embedded":
+		return dec.EmbeddedJSON(&t.embedded)
+	}
+	return nil
+}
+
+func (t *testNestedObject) NKeys() int {
+	return 0
+}
+
+func nestedJSON(open, end, closing string, n int) string {
+	return strings.Repeat(open, n) + end + strings.Repeat(closing, n)
+}
+
+func TestDecoderMaxDepth(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		json     string
+		v        func() any
+		maxDepth int
+		err      bool
+	}{
+		{
+			name:     "interface-at-limit",
+			json:     nestedJSON("[", "1", "]", 3),
+			v:        func() any { return new(any) },
+			maxDepth: 3,
+		},
+		{
+			name:     "interface-over-limit",
+			json:     nestedJSON(`{"a":[`, "1", "]}", 2),
+			v:        func() any { return new(any) },
+			maxDepth: 3,
+			err:      true,
+		},
+		{
+			name:     "array-at-limit",
+			json:     nestedJSON("[", "", "]", 4),
+			v:        func() any { return &testNestedArray{} },
+			maxDepth: 4,
+		},
+		{
+			name:     "array-over-limit",
+			json:     nestedJSON("[", "", "]", 5),
+			v:        func() any { return &testNestedArray{} },
+			maxDepth: 4,
+			err:      true,
+		},
+		{
+			name:     "object-over-limit",
+			json:     nestedJSON(`{"inner":`, "{}", "}", 4),
+			v:        func() any { return &testNestedObject{} },
+			maxDepth: 4,
+			err:      true,
+		},
+		{
+			name:     "skipped-at-limit",
+			json:     `{"inner":{"unknown":` + nestedJSON(`[{"a":`, "1", "}]", 1) + "}}",
+			v:        func() any { return &testNestedObject{} },
+			maxDepth: 4,
+		},
+		{
+			name:     "skipped-over-limit",
+			json:     `{"inner":{"unknown":` + nestedJSON(`[{"a":`, "1", "}]", 2) + "}}",
+			v:        func() any { return &testNestedObject{} },
+			maxDepth: 4,
+			err:      true,
+		},
+		{
+			name:     "embedded-over-limit",
+			json:     `{"embedded":` + nestedJSON("[", `"]]"`, "]", 4) + "}",
+			v:        func() any { return &testNestedObject{} },
+			maxDepth: 4,
+			err:      true,
+		},
+		{
+			name:     "default-over-limit",
+			json:     nestedJSON("[", "", "]", DefaultMaxDepth+1),
+			v:        func() any { return new(any) },
+			maxDepth: DefaultMaxDepth,
+			err:      true,
+		},
+		{
+			name:     "unlimited",
+			json:     nestedJSON("[", "", "]", DefaultMaxDepth+1),
+			v:        func() any { return new(any) },
+			maxDepth: 0,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := Unmarshal([]byte(testCase.json), testCase.v(), WithMaxDepth(testCase.maxDepth))
+			if !testCase.err {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.ErrorAs(t, err, new(MaxDepthError))
+			var decErr *DecodeError
+			require.ErrorAs(t, err, &decErr)
+			assert.Equal(t, fmt.Sprintf(maxDepthErrorMsg, testCase.maxDepth), decErr.Err.Error())
+		})
+	}
+}
+
+func TestDecoderMaxDepthOption(t *testing.T) {
+	t.Parallel()
+
+	dec := NewDecoder(strings.NewReader(`[[1]] [[[1]]]`), WithMaxDepth(2))
+	var v any
+	require.NoError(t, dec.Decode(&v))
+	assert.Equal(t, []any{[]any{1.0}}, v)
+	err := dec.Decode(&v)
+	assert.ErrorAs(t, err, new(MaxDepthError))
+
+	dec = BorrowDecoder(strings.NewReader(`[[1]]`), WithMaxDepth(1))
+	assert.Equal(t, 1, dec.maxDepth)
+	dec.Release()
+	assert.Equal(t, DefaultMaxDepth, dec.maxDepth)
+}
+
+func TestDecoderInputLimits(t *testing.T) {
+	t.Parallel()
+
+	longStr := `"` + strings.Repeat("a", 100) + `"`
+	testCases := []struct {
+		name    string
+		json    string
+		v       func() any
+		opts    []DecoderOption
+		errType any
+	}{
+		{
+			name: "max-bytes-exact",
+			json: `{"a":[1,2,3]}`,
+			v:    func() any { return new(any) },
+			opts: []DecoderOption{WithMaxBytes(13)},
+		},
+		{
+			name:    "max-bytes-exceeded",
+			json:    `{"a":[1,2,3]}`,
+			v:       func() any { return new(any) },
+			opts:    []DecoderOption{WithMaxBytes(12)},
+			errType: new(MaxBytesError),
+		},
+		{
+			name:    "max-bytes-exceeded-in-skipped-value",
+			json:    `{"skip":` + longStr + `,"testStr":"a"}`,
+			v:       func() any { return &testObject{} },
+			opts:    []DecoderOption{WithMaxBytes(64)},
+			errType: new(MaxBytesError),
+		},
+		{
+			name: "max-token-length-fits",
+			json: `{"testStr":"` + strings.Repeat("a", 10) + `","testInt":1234567890}`,
+			v:    func() any { return &testObject{} },
+			opts: []DecoderOption{WithMaxTokenLength(10)},
+		},
+		{
+			name: "max-token-length-spaces-between-tokens",
+			json: `{"a":"x",      "b":                        "y"}`,
+			v: func() any {
+				return DecodeObjectFunc(func(dec *Decoder, k string) error {
+					var s string
+					return dec.String(&s)
+				})
+			},
+			opts: []DecoderOption{WithMaxTokenLength(8)},
+		},
+		{
+			name: "max-token-length-spaces-before-values",
+			json: strings.ReplaceAll(
+				`{"testStr":_"x",_"testStrNull":_"y",_"testInt":_-1,_"testInt8":_2,_"testUint16":_3,_"testFloat64":_-1.5}`,
+				"_",
+				strings.Repeat(" ", 30),
+			),
+			v:    func() any { return &testObject{} },
+			opts: []DecoderOption{WithMaxTokenLength(12)},
+		},
+		{
+			name:    "max-token-length-string",
+			json:    `{"testStr":` + longStr + `}`,
+			v:       func() any { return &testObject{} },
+			opts:    []DecoderOption{WithMaxTokenLength(10)},
+			errType: new(MaxTokenLengthError),
+		},
+		{
+			name:    "max-token-length-skipped-string",
+			json:    `{"skip":` + longStr + `}`,
+			v:       func() any { return &testObject{} },
+			opts:    []DecoderOption{WithMaxTokenLength(10)},
+			errType: new(MaxTokenLengthError),
+		},
+		{
+			name:    "max-token-length-key",
+			json:    `{` + longStr + `:1}`,
+			v:       func() any { return &testObject{} },
+			opts:    []DecoderOption{WithMaxTokenLength(10)},
+			errType: new(MaxTokenLengthError),
+		},
+		{
+			name:    "max-token-length-number",
+			json:    `{"testInt64":1` + strings.Repeat("0", 17) + `}`,
+			v:       func() any { return &testObject{} },
+			opts:    []DecoderOption{WithMaxTokenLength(10)},
+			errType: new(MaxTokenLengthError),
+		},
+		{
+			name:    "max-token-length-interface-number",
+			json:    `[1.` + strings.Repeat("5", 20) + `]`,
+			v:       func() any { return new(any) },
+			opts:    []DecoderOption{WithMaxTokenLength(10)},
+			errType: new(MaxTokenLengthError),
+		},
+		{
+			name: "max-buffer-size-fits",
+			json: `[` + longStr + `]`,
+			v:    func() any { return new(any) },
+			opts: []DecoderOption{WithMaxBufferSize(128)},
+		},
+		{
+			name:    "max-buffer-size-exceeded",
+			json:    `[` + longStr + `,` + longStr + `]`,
+			v:       func() any { return new(any) },
+			opts:    []DecoderOption{WithMaxBufferSize(128)},
+			errType: new(MaxBufferSizeError),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			dec := BorrowDecoder(iotest.OneByteReader(strings.NewReader(testCase.json)), testCase.opts...)
+			defer dec.Release()
+			err := dec.Decode(testCase.v())
+			if testCase.errType == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.ErrorAs(t, err, testCase.errType)
+			assert.ErrorAs(t, err, new(*DecodeError))
+		})
+	}
+}
+
+func TestDecoderMaxTokenLengthUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	var s string
+	err := Unmarshal([]byte(`"`+strings.Repeat("a", 11)+`"`), &s, WithMaxTokenLength(10))
+	assert.ErrorAs(t, err, new(MaxTokenLengthError))
+	err = Unmarshal([]byte(`"`+strings.Repeat("a", 10)+`"`), &s, WithMaxTokenLength(10))
+	require.NoError(t, err)
+	assert.Equal(t, strings.Repeat("a", 10), s)
+}
+
+func TestDecoderMaxBufferSizeStream(t *testing.T) {
+	t.Parallel()
+
+	input := strings.Repeat(`{"testStr":"`+strings.Repeat("a", 40)+`"}`+"\n", 100)
+	c := ChannelStreamObjects(make(chan *testObject, 100))
+	dec := Stream.NewDecoder(strings.NewReader(input), WithMaxBufferSize(128))
+	require.NoError(t, dec.DecodeStream(c))
+	assert.Len(t, c, 100)
+	assert.LessOrEqual(t, len(dec.data), 128)
+}
+
+func TestDecoderReaderErrorIsReported(t *testing.T) {
+	t.Parallel()
+
+	readErr := errors.New("connection reset")
+	r := io.MultiReader(strings.NewReader(`{"testStr":"hel`), iotest.ErrReader(readErr))
+	dec := NewDecoder(r)
+	err := dec.Decode(&testObject{})
+	assert.Equal(t, readErr, err)
+}
+
+func TestDecoderStrict(t *testing.T) {
+	t.Parallel()
+
+	allowMeta := func(path, key string) bool {
+		return path == "$" && strings.HasPrefix(key, "_")
+	}
+	testCases := []struct {
+		name string
+		json string
+		opts []DecoderOption
+		key  string
+		path string
+	}{
+		{
+			name: "all-keys-known",
+			json: `{"id":"a","items":[{"price":1},{"price":2}]}`,
+		},
+		{
+			name: "unknown-root-key",
+			json: `{"id":"a","extra":{"a":1},"items":[]}`,
+			key:  "extra",
+			path: "$.extra",
+		},
+		{
+			name: "unknown-key-in-bounded-object",
+			json: `{"id":"a","items":[{"price":1},{"price":2,"currency":"EUR"}]}`,
+			key:  "currency",
+			path: "$.items[1].currency",
+		},
+		{
+			name: "allowed-key",
+			json: `{"id":"a","_meta":{"a":1},"items":[{"price":1}]}`,
+			opts: []DecoderOption{WithStrictAllow(allowMeta)},
+		},
+		{
+			name: "not-allowed-key",
+			json: `{"id":"a","items":[{"price":1,"_meta":1}]}`,
+			opts: []DecoderOption{WithStrictAllow(allowMeta)},
+			key:  "_meta",
+			path: "$.items[0]._meta",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			v := &testPositionOrder{}
+			err := UnmarshalStrict([]byte(testCase.json), v, testCase.opts...)
+			if testCase.key == "" {
+				require.NoError(t, err)
+				return
+			}
+			var keyErr *UnknownKeyError
+			require.ErrorAs(t, err, &keyErr)
+			assert.Equal(t, testCase.key, keyErr.Key)
+			assert.Equal(t, testCase.path, keyErr.Path)
+			assert.Equal(t, fmt.Sprintf("Unknown key %q at %s", testCase.key, testCase.path), keyErr.Error())
+			var decErr *DecodeError
+			require.ErrorAs(t, err, &decErr)
+			assert.Equal(t, testCase.path, decErr.Path)
+			// without strict mode unknown keys are skipped
+			require.NoError(t, Unmarshal([]byte(testCase.json), &testPositionOrder{}))
+		})
+	}
+}
+
+func TestDecoderStrictReader(t *testing.T) {
+	t.Parallel()
+
+	dec := BorrowDecoder(strings.NewReader(`{"testStr":"a","unknown":true}`), WithStrict())
+	defer dec.Release()
+	err := dec.Decode(&testObject{})
+	assert.ErrorAs(t, err, new(*UnknownKeyError))
+}
+
+func TestDecoderDuplicateKeys(t *testing.T) {
+	t.Parallel()
+
+	// the second item is a bounded-key object, its duplicate key comes after all keys were decoded,
+	// escaped keys are duplicates of the unescaped ones
+	const orderJSON = `{"id":"a","items":[{"price":1},{"price":2,"price":3}],"\u0069d":"b"}`
+	const mapJSON = `{"a":"1","b":"3","\u0061":"2"}`
+	testCases := []struct {
+		name   string
+		policy DuplicateKeyPolicy
+		id     string
+		price  int
+		m      map[string]string
+		path   string
+	}{
+		{
+			name:   "last-wins",
+			policy: DuplicateKeyLastWins,
+			id:     "b",
+			price:  2,
+			m:      map[string]string{"a": "2", "b": "3"},
+		},
+		{
+			name:   "first-wins",
+			policy: DuplicateKeyFirstWins,
+			id:     "a",
+			price:  2,
+			m:      map[string]string{"a": "1", "b": "3"},
+		},
+		{
+			name:   "fails",
+			policy: DuplicateKeyFails,
+			path:   "$.items[1].price",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			opt := WithDuplicateKeyPolicy(testCase.policy)
+			v := &testPositionOrder{}
+			err := Unmarshal([]byte(orderJSON), v, opt)
+			if testCase.path != "" {
+				var keyErr *DuplicateKeyError
+				require.ErrorAs(t, err, &keyErr)
+				assert.Equal(t, "price", keyErr.Key)
+				assert.Equal(t, testCase.path, keyErr.Path)
+				assert.Equal(t, `Duplicate key "price" at `+testCase.path, keyErr.Error())
+				var decErr *DecodeError
+				require.ErrorAs(t, err, &decErr)
+				assert.Equal(t, 50, decErr.Offset, "the duplicate value is located")
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.id, v.id)
+				require.Len(t, v.items, 2)
+				assert.Equal(t, testCase.price, v.items[1].price)
+			}
+
+			m := map[string]string{}
+			err = UnmarshalJSONObject([]byte(mapJSON), DecodeObjectFunc(func(dec *Decoder, k string) error {
+				var s string
+				m[k] = ""
+				if err := dec.String(&s); err != nil {
+					return err
+				}
+				m[k] = s
+				return nil
+			}), opt)
+			if testCase.m == nil {
+				var decErr *DecodeError
+				require.ErrorAs(t, err, &decErr)
+				assert.Equal(t, "$.a", decErr.Path)
+				assert.ErrorAs(t, err, new(*DuplicateKeyError))
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.m, m)
+			}
+
+			var i any
+			err = Unmarshal([]byte(mapJSON), &i, opt)
+			if testCase.m == nil {
+				require.ErrorAs(t, err, new(*DuplicateKeyError))
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, map[string]any{"a": testCase.m["a"], "b": "3"}, i)
+			}
+
+			m = map[string]string{}
+			dec := NewDecoder(strings.NewReader(mapJSON), opt)
+			for k, dec := range dec.ObjectIter() {
+				var s string
+				require.NoError(t, dec.String(&s))
+				m[k] = s
+			}
+			if testCase.m == nil {
+				require.ErrorAs(t, dec.Err(), new(*DuplicateKeyError))
+			} else {
+				require.NoError(t, dec.Err())
+				assert.Equal(t, testCase.m, m)
+			}
+		})
+	}
+}
+
+func TestDecoderUTF8Policy(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		json   string
+		policy UTF8Policy
+		result string
+		offset int
+	}{
+		{name: "unchecked-invalid-byte", json: "\"a\xffb\"", policy: UTF8Unchecked, result: "a\xffb"},
+		{name: "unchecked-lone-surrogate", json: `"\ud800x"`, policy: UTF8Unchecked, result: "\uFFFDx"},
+		{name: "replace-valid", json: `"é\u00e9\ud83d\ude00"`, policy: UTF8Replace, result: "éé😀"},
+		{name: "replace-invalid-bytes", json: "\"a\xff\xc3b\\n\xed\xa0\x80\"", policy: UTF8Replace, result: "a\uFFFD\uFFFDb\n\uFFFD\uFFFD\uFFFD"},
+		{name: "replace-lone-high-surrogate", json: `"\ud800x"`, policy: UTF8Replace, result: "\uFFFDx"},
+		{name: "replace-lone-low-surrogate", json: `"\udc00"`, policy: UTF8Replace, result: "\uFFFD"},
+		{name: "replace-surrogate-before-pair", json: `"\ud800\ud83d\ude00"`, policy: UTF8Replace, result: "\uFFFD😀"},
+		{name: "replace-surrogate-before-escape", json: `"\ud800\"\u0041"`, policy: UTF8Replace, result: "\uFFFD\"A"},
+		{name: "reject-valid", json: `"é\ud83d\ude00"`, policy: UTF8Reject, result: "é😀"},
+		{name: "reject-invalid-byte", json: "\"\\tab\xff\"", policy: UTF8Reject, offset: 5},
+		{name: "reject-lone-surrogate", json: `"ab\ud800\u0041"`, policy: UTF8Reject, offset: 3},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			opt := WithUTF8Policy(testCase.policy)
+			for _, r := range []io.Reader{strings.NewReader(testCase.json), iotest.OneByteReader(strings.NewReader(testCase.json))} {
+				var s string
+				dec := NewDecoder(r, opt)
+				err := dec.DecodeString(&s)
+				if testCase.result != "" {
+					require.NoError(t, err)
+					assert.Equal(t, testCase.result, s)
+					continue
+				}
+				var decErr *DecodeError
+				require.ErrorAs(t, err, &decErr)
+				assert.ErrorAs(t, err, new(InvalidUTF8Error))
+				assert.Equal(t, testCase.offset, decErr.Offset)
+			}
+		})
+	}
+}
+
+func TestDecoderUTF8PolicyEscapes(t *testing.T) {
+	t.Parallel()
+
+	// invalid bytes and escape sequences in the same string, followed by other strings
+	var v any
+	require.NoError(t, Unmarshal([]byte("[\"\xa9\\n\",\"x\"]"), &v, WithUTF8Policy(UTF8Replace)))
+	assert.Equal(t, []any{"\uFFFD\n", "x"}, v)
+
+	data := "{\"a\":\"\\t\xa9\\n\xff\\u00e9\",\"b\":\"x\",\"c\":1x}"
+	for _, r := range []io.Reader{strings.NewReader(data), iotest.OneByteReader(strings.NewReader(data))} {
+		var m any
+		err := NewDecoder(r, WithUTF8Policy(UTF8Replace)).Decode(&m)
+		var decErr *DecodeError
+		require.ErrorAs(t, err, &decErr)
+		assert.Equal(t, 33, decErr.Offset, "positions account for the escape sequences and the replaced bytes")
+		assert.Equal(t, "$.c", decErr.Path)
+	}
+}
+
+func TestDecoderUTF8PolicyKeys(t *testing.T) {
+	t.Parallel()
+
+	data := []byte("{\"k\xff\":\"v\xff\",\"id\":\"a\",\"items\":[{\"price\":1x}]}")
+	keys := []string{}
+	err := UnmarshalJSONObject(data, DecodeObjectFunc(func(dec *Decoder, k string) error {
+		keys = append(keys, k)
+		return nil
+	}), WithUTF8Policy(UTF8Replace))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"k\uFFFD", "id", "items"}, keys)
+
+	// positions account for the replaced bytes
+	v := &testPositionOrder{}
+	err = Unmarshal(data, v, WithUTF8Policy(UTF8Replace))
+	var decErr *DecodeError
+	require.ErrorAs(t, err, &decErr)
+	assert.Equal(t, 38, decErr.Offset)
+	assert.Equal(t, "$.items[0].price", decErr.Path)
+
+	err = Unmarshal(data, &testPositionOrder{}, WithUTF8Policy(UTF8Reject))
+	require.ErrorAs(t, err, &decErr)
+	assert.ErrorAs(t, err, new(InvalidUTF8Error))
+	assert.Equal(t, 3, decErr.Offset)
+	assert.Equal(t, "$", decErr.Path)
+}
@@ -0,0 +1,311 @@
+package gojay
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	overlappingPointerErrorMsg = "JSON pointer %q overlaps %q"
+	pathFuncsErrorMsg          = "Invalid usage of paths, %d funcs given for %d paths"
+)
+
+// InvalidUsagePathsError is returned by DecodePaths and UnmarshalPaths
+// when the number of funcs given is not the number of paths.
+type InvalidUsagePathsError string
+
+func (err InvalidUsagePathsError) Error() string {
+	return string(err)
+}
+
+// Paths is a set of JSON pointers (RFC 6901) compiled by CompilePaths to be decoded in a single pass
+// by Decoder.DecodePaths or UnmarshalPaths. It is safe for concurrent use.
+type Paths struct {
+	ptrs []string
+	root pathNode
+}
+
+// pathNode is an object key or an array index of a compiled set of paths.
+type pathNode struct {
+	// tok is the reference token leading to the node, pushed to the path of the Decoder
+	tok     string
+	keys    map[string]*pathNode
+	indexes map[int]*pathNode
+	// leaf is the index of the path ending at the node plus one, 0 if no path ends at it
+	leaf int
+	// n is the number of paths ending at or under the node
+	n int
+}
+
+// CompilePaths compiles a set of JSON pointers, like "/tenant" or "/events/0/id".
+// An InvalidPointerError is returned if a pointer is invalid, if it is given twice
+// or if it references a value within the value of another one.
+func CompilePaths(ptrs ...string) (*Paths, error) {
+	p := &Paths{ptrs: ptrs}
+	for i, ptr := range ptrs {
+		if ptr != "" && ptr[0] != '/' {
+			return nil, InvalidPointerError(fmt.Sprintf(invalidPointerErrorMsg, ptr))
+		}
+		n := &p.root
+		n.n++
+		for rest, more := strings.CutPrefix(ptr, "/"); more; {
+			var tok string
+			tok, rest, more = strings.Cut(rest, "/")
+			tok, ok := unescapePointerToken(tok)
+			if !ok {
+				return nil, InvalidPointerError(fmt.Sprintf(invalidPointerErrorMsg, ptr))
+			}
+			if n.leaf > 0 {
+				return nil, InvalidPointerError(fmt.Sprintf(overlappingPointerErrorMsg, ptr, ptrs[n.leaf-1]))
+			}
+			n = n.child(tok)
+			n.n++
+		}
+		if n.leaf > 0 || n.n > 1 {
+			return nil, InvalidPointerError(fmt.Sprintf(overlappingPointerErrorMsg, ptr, p.overlapping(n)))
+		}
+		n.leaf = i + 1
+	}
+	return p, nil
+}
+
+// child returns the child of the node for the reference token tok, adding it if needed.
+// A token being an array index references the same node as the object key.
+func (n *pathNode) child(tok string) *pathNode {
+	if c, ok := n.keys[tok]; ok {
+		return c
+	}
+	c := &pathNode{tok: tok}
+	if n.keys == nil {
+		n.keys = make(map[string]*pathNode)
+	}
+	n.keys[tok] = c
+	if i, err := strconv.Atoi(tok); err == nil && i >= 0 && tok[0] != '+' && (tok[0] != '0' || len(tok) == 1) {
+		if n.indexes == nil {
+			n.indexes = make(map[int]*pathNode)
+		}
+		n.indexes[i] = c
+	}
+	return c
+}
+
+// overlapping returns a pointer ending at or under the node n.
+func (p *Paths) overlapping(n *pathNode) string {
+	for n.leaf == 0 {
+		for _, c := range n.keys {
+			n = c
+			break
+		}
+	}
+	return p.ptrs[n.leaf-1]
+}
+
+// Len returns the number of paths of the set.
+func (p *Paths) Len() int {
+	return len(p.ptrs)
+}
+
+// DecodePaths reads the next JSON value from the decoder's input (io.Reader) in a single pass,
+// calling for each path of p the func of the same index once the value it references is reached.
+// A func decodes the value with a method of the Decoder, like dec.String or dec.Object,
+// the value being skipped if it doesn't. The paths not found in the input are ignored.
+//
+// The values which are not on a path are skipped without being decoded
+// and reading stops as soon as all the paths are found, the rest of the value is then left unread.
+// An error returned by a func stops decoding and is returned.
+// If the number of funcs is not the number of paths, an InvalidUsagePathsError is returned without reading the input.
+func (dec *Decoder) DecodePaths(p *Paths, fns ...func(dec *Decoder) error) error {
+	if dec.isPooled == 1 {
+		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
+	}
+	dec.compact()
+	return dec.decodePaths(p, fns)
+}
+
+// UnmarshalPaths decodes the paths of p in the JSON-encoded data in a single pass, see Decoder.DecodePaths.
+func UnmarshalPaths(data []byte, p *Paths, fns ...func(dec *Decoder) error) error {
+	dec := borrowDecoder(nil, 0)
+	defer dec.Release()
+	dec.data = make([]byte, len(data))
+	copy(dec.data, data)
+	dec.length = len(data)
+	return dec.decodePaths(p, fns)
+}
+
+func (dec *Decoder) decodePaths(p *Paths, fns []func(dec *Decoder) error) error {
+	if len(fns) != len(p.ptrs) {
+		return InvalidUsagePathsError(fmt.Sprintf(pathFuncsErrorMsg, len(fns), len(p.ptrs)))
+	}
+	// remember the path and nesting depth as decoding may stop within the value
+	depth := len(dec.path)
+	nesting := dec.depth
+	defer func() {
+		dec.path = dec.path[:depth]
+		dec.depth = nesting
+	}()
+	if p.root.n == 0 {
+		return dec.skipData()
+	}
+	st := &pathsState{fns: fns, left: p.root.n, found: make([]bool, len(fns))}
+	if err := dec.decodePathNode(&p.root, st); err != nil {
+		return err
+	}
+	return dec.err
+}
+
+// pathsState is the state of a call to DecodePaths, left being the number of paths not found yet.
+type pathsState struct {
+	fns   []func(dec *Decoder) error
+	left  int
+	found []bool
+}
+
+// decodePathNode decodes the next value, referenced by the node n.
+func (dec *Decoder) decodePathNode(n *pathNode, st *pathsState) error {
+	if n.leaf > 0 {
+		dec.called = 0
+		if err := st.fns[n.leaf-1](dec); err != nil {
+			dec.err = err
+			return err
+		} else if dec.err != nil {
+			return dec.err
+		} else if dec.called&1 == 0 {
+			if err := dec.skipData(); err != nil {
+				return err
+			}
+		}
+		dec.called = 0
+		// a duplicate key is decoded again but the path is only counted once
+		if !st.found[n.leaf-1] {
+			st.found[n.leaf-1] = true
+			st.left--
+		}
+		return nil
+	}
+	switch dec.skipSpaces() {
+	case '{', '[':
+		dec.depth++
+		if err := dec.checkDepth(dec.depth, dec.cursor); err != nil {
+			return err
+		}
+		c := dec.data[dec.cursor]
+		dec.cursor++
+		if c == '{' {
+			return dec.decodePathKeys(n, st)
+		}
+		return dec.decodePathIndexes(n, st)
+	case 0:
+		return dec.raiseUnexpectedErr(dec.cursor, "value")
+	default:
+		// the paths under a scalar are not found
+		return dec.skipData()
+	}
+}
+
+// decodePathKeys decodes the keys of the object being read which are on a path under the node n.
+func (dec *Decoder) decodePathKeys(n *pathNode, st *pathsState) error {
+	found := st.left - n.n
+	for st.left > 0 {
+		if st.left == found {
+			// all the paths under the object are found, the rest of it is skipped
+			return dec.skipPathValue(dec.skipObject)
+		}
+		dec.discardRead()
+		switch dec.nextChar() {
+		case '}':
+			dec.cursor++
+			dec.depth--
+			return nil
+		case '"':
+			dec.cursor++
+			start := dec.cursor
+			if err := dec.skipString(); err != nil {
+				return err
+			}
+			c := n.keyNode(dec.data[start : dec.cursor-1])
+			if dec.skipSpaces() != ':' {
+				return dec.raiseUnexpectedErr(dec.cursor, "':'")
+			}
+			dec.cursor++
+			if c == nil {
+				if err := dec.skipData(); err != nil {
+					return err
+				}
+				continue
+			}
+			dec.path = append(dec.path, pathElem{key: c.tok, index: -1})
+			if err := dec.decodePathNode(c, st); err != nil {
+				return err
+			}
+			dec.path = dec.path[:len(dec.path)-1]
+		default:
+			return dec.raiseUnexpectedErr(dec.cursor, "string key or '}'")
+		}
+	}
+	return nil
+}
+
+// decodePathIndexes decodes the elements of the array being read which are on a path under the node n.
+func (dec *Decoder) decodePathIndexes(n *pathNode, st *pathsState) error {
+	found := st.left - n.n
+	for i := 0; st.left > 0; i++ {
+		if st.left == found {
+			return dec.skipPathValue(dec.skipArray)
+		}
+		dec.discardRead()
+		switch dec.nextChar() {
+		case ']':
+			dec.cursor++
+			dec.depth--
+			return nil
+		case 0:
+			return dec.raiseUnexpectedErr(dec.cursor, "value")
+		}
+		c := n.indexes[i]
+		if c == nil {
+			if err := dec.skipData(); err != nil {
+				return err
+			}
+			continue
+		}
+		dec.path = append(dec.path, pathElem{index: i})
+		if err := dec.decodePathNode(c, st); err != nil {
+			return err
+		}
+		dec.path = dec.path[:len(dec.path)-1]
+	}
+	return nil
+}
+
+// skipPathValue skips the rest of the object or array being read with skip, skipObject or skipArray.
+func (dec *Decoder) skipPathValue(skip func() (int, error)) error {
+	dec.depth--
+	end, err := skip()
+	if err != nil {
+		return err
+	}
+	dec.cursor = end
+	return nil
+}
+
+// keyNode returns the child of the node n for the raw key read from the input, nil if the key is on no path.
+func (n *pathNode) keyNode(raw []byte) *pathNode {
+	if bytes.IndexByte(raw, '\\') < 0 {
+		return n.keys[string(raw)]
+	}
+	k, ok := unescapeKey(raw)
+	if !ok {
+		return nil
+	}
+	return n.keys[k]
+}
+
+// discardRead drops the values already skipped from the buffer of a Decoder reading from an io.Reader,
+// unless the buffer is reused as the values decoded so far point to it.
+func (dec *Decoder) discardRead() {
+	if dec.r != nil && !dec.reuseBuffer && dec.cursor > 0 {
+		dec.discard(dec.cursor)
+	}
+}
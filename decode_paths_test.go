@@ -0,0 +1,158 @@
+package gojay
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPathsJSON = `{
+	"meta": {"tenant": "acme", "type": "order", "skipped": [{"tenant": "other"}, "}]\"{["]},
+	"events": [{"id": 1}, {"id": 2, "ts": 1700000000}],
+	"a\/b": true,
+	"id": "ord-1",
+	"payload": {"large": "` + "xxxxxxxxxx" + `"}
+}`
+
+func TestDecodePaths(t *testing.T) {
+	t.Parallel()
+
+	paths, err := CompilePaths("/meta/tenant", "/meta/type", "/events/1/ts", "/id", "/a~1b", "/missing")
+	require.NoError(t, err)
+	assert.Equal(t, 6, paths.Len())
+
+	var tenant, typ, id string
+	var ts int64
+	var ok bool
+	fns := []func(dec *Decoder) error{
+		func(dec *Decoder) error { return dec.String(&tenant) },
+		func(dec *Decoder) error { return dec.String(&typ) },
+		func(dec *Decoder) error { return dec.Int64(&ts) },
+		func(dec *Decoder) error { return dec.String(&id) },
+		func(dec *Decoder) error { return dec.Bool(&ok) },
+		func(*Decoder) error { return errors.New("not found") },
+	}
+	data := []byte(testPathsJSON)
+	require.NoError(t, UnmarshalPaths(data, paths, fns...))
+	assert.Equal(t, "acme", tenant)
+	assert.Equal(t, "order", typ)
+	assert.Equal(t, int64(1700000000), ts)
+	assert.Equal(t, "ord-1", id)
+	assert.True(t, ok)
+	assert.Equal(t, testPathsJSON, string(data))
+
+	tenant, id = "", ""
+	dec := NewDecoder(iotest.OneByteReader(strings.NewReader(testPathsJSON)))
+	require.NoError(t, dec.DecodePaths(paths, fns...))
+	assert.Equal(t, "acme", tenant)
+	assert.Equal(t, "ord-1", id)
+}
+
+func TestDecodePathsShortCircuit(t *testing.T) {
+	t.Parallel()
+
+	paths, err := CompilePaths("/tenant", "/id")
+	require.NoError(t, err)
+
+	r := strings.NewReader(`{"id": 1, ` + strings.Repeat(`"skipped": {"a": "xxxxxxxxxx"}, `, 1000) + `"tenant": "acme", "after": ` +
+		strings.Repeat(" ", 1<<16) + `1}`)
+	dec := BorrowDecoder(r)
+	defer dec.Release()
+	var tenant string
+	var id int
+	var calls int
+	require.NoError(t, dec.DecodePaths(paths,
+		func(dec *Decoder) error { calls++; return dec.String(&tenant) },
+		func(dec *Decoder) error { calls++; return dec.Int(&id) },
+	))
+	assert.Equal(t, "acme", tenant)
+	assert.Equal(t, 1, id)
+	assert.Equal(t, 2, calls)
+	assert.Positive(t, r.Len(), "the input after the paths is not read")
+	assert.Less(t, cap(dec.data), 4096, "the values skipped are not buffered")
+}
+
+func TestDecodePathsObjects(t *testing.T) {
+	t.Parallel()
+
+	paths, err := CompilePaths("/users/0", "/users/1/name", "/count")
+	require.NoError(t, err)
+
+	u := &testSliceUser{}
+	var name string
+	var count int
+	var skipped bool
+	err = UnmarshalPaths([]byte(`{"users": [{"id": 1, "name": "a"}, {"name": "b", "id": 2}, {}], "count": 3}`), paths,
+		func(dec *Decoder) error { return dec.Object(u) },
+		func(dec *Decoder) error { return dec.String(&name) },
+		func(*Decoder) error {
+			// the value is skipped if it is not decoded
+			skipped = true
+			return nil
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, &testSliceUser{id: 1, name: "a"}, u)
+	assert.Equal(t, "b", name)
+	assert.Zero(t, count)
+	assert.True(t, skipped)
+}
+
+func TestDecodePathsErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, ptrs := range [][]string{{"tenant"}, {"/a~2"}, {"/a", "/a"}, {"/a", "/a/b"}, {"/a/b", "/a"}, {"", "/a"}} {
+		_, err := CompilePaths(ptrs...)
+		var invalid InvalidPointerError
+		assert.ErrorAs(t, err, &invalid, ptrs)
+	}
+
+	paths, err := CompilePaths("/a/1", "/b")
+	require.NoError(t, err)
+	var b string
+	err = UnmarshalPaths([]byte(`{"a": [1, "x"], "b": "ok"}`), paths,
+		func(dec *Decoder) error {
+			var v int
+			return dec.Int(&v)
+		},
+		func(dec *Decoder) error { return dec.String(&b) },
+	)
+	var decErr *DecodeError
+	require.ErrorAs(t, err, &decErr)
+	assert.IsType(t, InvalidUnmarshalError(""), decErr.Err)
+	assert.Equal(t, "$.a[1]", decErr.Path)
+	assert.Empty(t, b, "decoding stops at the first error")
+
+	errCallback := errors.New("callback")
+	err = UnmarshalPaths([]byte(`{"a": [1, 2], "b": "ok"}`), paths,
+		func(*Decoder) error { return errCallback },
+		func(dec *Decoder) error { return dec.String(&b) },
+	)
+	require.ErrorIs(t, err, errCallback)
+
+	err = UnmarshalPaths([]byte(`{"a": [1, 2`), paths,
+		func(dec *Decoder) error { return nil },
+		func(dec *Decoder) error { return dec.String(&b) },
+	)
+	require.ErrorAs(t, err, &decErr)
+	assert.IsType(t, InvalidJSONError(""), decErr.Err)
+
+	err = UnmarshalPaths([]byte(`{}`), paths)
+	var usageErr InvalidUsagePathsError
+	require.ErrorAs(t, err, &usageErr)
+	assert.Equal(t, "Invalid usage of paths, 0 funcs given for 2 paths", usageErr.Error())
+
+	dec := NewDecoder(strings.NewReader(`{"b": "ok"}`))
+	err = dec.DecodePaths(paths, func(dec *Decoder) error { return nil })
+	require.ErrorAs(t, err, &usageErr)
+	assert.Equal(t, "Invalid usage of paths, 1 funcs given for 2 paths", usageErr.Error())
+	require.NoError(t, dec.DecodePaths(paths,
+		func(dec *Decoder) error { return nil },
+		func(dec *Decoder) error { return dec.String(&b) },
+	), "the input is not read")
+	assert.Equal(t, "ok", b)
+}
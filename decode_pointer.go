@@ -0,0 +1,299 @@
+package gojay
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	invalidPointerErrorMsg  = "Invalid JSON pointer %q"
+	pointerNotFoundErrorMsg = "JSON pointer %q not found"
+)
+
+// InvalidPointerError is returned when a JSON pointer doesn't follow RFC 6901.
+type InvalidPointerError string
+
+func (err InvalidPointerError) Error() string {
+	return string(err)
+}
+
+// PointerNotFoundError is returned when a JSON pointer references a value which doesn't exist in the input.
+type PointerNotFoundError string
+
+func (err PointerNotFoundError) Error() string {
+	return string(err)
+}
+
+// GetPointer returns the raw JSON value referenced by the JSON pointer ptr (RFC 6901) in data,
+// like "/data/items/0/id", the empty pointer referencing the whole document.
+//
+// The values before the target are skipped without being decoded and the input after it is not read.
+// The returned EmbeddedJSON points to data, it is not copied.
+func GetPointer(data []byte, ptr string) (EmbeddedJSON, error) {
+	dec := borrowDecoder(nil, 0)
+	defer dec.releaseBytes()
+	dec.data = data
+	dec.length = len(data)
+	if err := dec.walkPointer(ptr); err != nil {
+		return nil, err
+	}
+	dec.skipSpaces()
+	start := dec.cursor
+	if err := dec.skipData(); err != nil {
+		return nil, err
+	}
+	return EmbeddedJSON(data[start:dec.cursor]), nil
+}
+
+// GetString returns the string referenced by the JSON pointer ptr in data, see GetPointer.
+func GetString(data []byte, ptr string) (string, error) {
+	var v string
+	err := getPointer(data, ptr, func(dec *Decoder) error {
+		return dec.decodeString(&v)
+	})
+	return v, err
+}
+
+// GetInt64 returns the int64 referenced by the JSON pointer ptr in data, see GetPointer.
+func GetInt64(data []byte, ptr string) (int64, error) {
+	var v int64
+	err := getPointer(data, ptr, func(dec *Decoder) error {
+		return dec.decodeInt64(&v)
+	})
+	return v, err
+}
+
+// GetUint64 returns the uint64 referenced by the JSON pointer ptr in data, see GetPointer.
+func GetUint64(data []byte, ptr string) (uint64, error) {
+	var v uint64
+	err := getPointer(data, ptr, func(dec *Decoder) error {
+		return dec.decodeUint64(&v)
+	})
+	return v, err
+}
+
+// GetFloat64 returns the float64 referenced by the JSON pointer ptr in data, see GetPointer.
+func GetFloat64(data []byte, ptr string) (float64, error) {
+	var v float64
+	err := getPointer(data, ptr, func(dec *Decoder) error {
+		return dec.decodeFloat64(&v)
+	})
+	return v, err
+}
+
+// GetBool returns the bool referenced by the JSON pointer ptr in data, see GetPointer.
+func GetBool(data []byte, ptr string) (bool, error) {
+	var v bool
+	err := getPointer(data, ptr, func(dec *Decoder) error {
+		return dec.decodeBool(&v)
+	})
+	return v, err
+}
+
+// GetNumber returns the literal of the number referenced by the JSON pointer ptr in data, see GetPointer.
+func GetNumber(data []byte, ptr string) (Number, error) {
+	var v Number
+	err := getPointer(data, ptr, func(dec *Decoder) error {
+		return dec.decodeNumber(&v)
+	})
+	return v, err
+}
+
+// getPointer decodes the value referenced by ptr in data with decode.
+// Values are decoded in place, the target is copied not to modify data.
+func getPointer(data []byte, ptr string, decode func(dec *Decoder) error) error {
+	dec := borrowDecoder(nil, 0)
+	defer dec.releaseBytes()
+	dec.data = data
+	dec.length = len(data)
+	if err := dec.walkPointer(ptr); err != nil {
+		return err
+	}
+	dec.skipSpaces()
+	start := dec.cursor
+	if err := dec.skipData(); err != nil {
+		return err
+	}
+	// errors are located in data
+	dec.pos = dec.position(start)
+	dec.posAt = 0
+	dec.data = append([]byte(nil), data[start:dec.cursor]...)
+	dec.length = len(dec.data)
+	dec.cursor = 0
+	if err := decode(dec); err != nil {
+		return err
+	}
+	return dec.err
+}
+
+// releaseBytes releases a Decoder reading bytes, the pool must not keep them.
+func (dec *Decoder) releaseBytes() {
+	dec.data = nil
+	dec.Release()
+}
+
+// DecodePointer decodes the value referenced by the JSON pointer ptr (RFC 6901) in the input of the Decoder
+// and stores it in the value pointed to by v, like Decode.
+//
+// The values before the target are skipped without being decoded,
+// reading from the io.Reader stops once the target is decoded.
+func (dec *Decoder) DecodePointer(ptr string, v any) error {
+	if dec.isPooled == 1 {
+		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
+	}
+	dec.compact()
+	depth := len(dec.path)
+	defer func() {
+		dec.path = dec.path[:depth]
+	}()
+	if err := dec.walkPointer(ptr); err != nil {
+		return err
+	}
+	return dec.Decode(v)
+}
+
+// walkPointer moves the cursor to the value referenced by the JSON pointer ptr,
+// the values before it are skipped without modifying the buffer.
+func (dec *Decoder) walkPointer(ptr string) error {
+	if ptr == "" {
+		return nil
+	}
+	if ptr[0] != '/' {
+		return InvalidPointerError(fmt.Sprintf(invalidPointerErrorMsg, ptr))
+	}
+	for rest := ptr[1:]; ; {
+		tok, next, more := strings.Cut(rest, "/")
+		tok, ok := unescapePointerToken(tok)
+		if !ok {
+			return InvalidPointerError(fmt.Sprintf(invalidPointerErrorMsg, ptr))
+		}
+		var err error
+		switch dec.skipSpaces() {
+		case '{':
+			dec.cursor++
+			err = dec.walkKey(tok, ptr)
+		case '[':
+			dec.cursor++
+			err = dec.walkIndex(tok, ptr)
+		case 0:
+			err = dec.raiseUnexpectedErr(dec.cursor, "value")
+		default:
+			err = PointerNotFoundError(fmt.Sprintf(pointerNotFoundErrorMsg, ptr))
+		}
+		if err != nil || !more {
+			return err
+		}
+		rest = next
+	}
+}
+
+// walkKey moves the cursor to the value of the key k of the object being read.
+func (dec *Decoder) walkKey(k, ptr string) error {
+	for {
+		if dec.r != nil {
+			// the values skipped are not kept in the buffer
+			dec.discard(dec.cursor)
+		}
+		switch dec.nextChar() {
+		case '}':
+			return PointerNotFoundError(fmt.Sprintf(pointerNotFoundErrorMsg, ptr))
+		case '"':
+			dec.cursor++
+			start := dec.cursor
+			if err := dec.skipString(); err != nil {
+				return err
+			}
+			match := keyEquals(dec.data[start:dec.cursor-1], k)
+			if dec.skipSpaces() != ':' {
+				return dec.raiseUnexpectedErr(dec.cursor, "':'")
+			}
+			dec.cursor++
+			if match {
+				dec.path = append(dec.path, pathElem{key: k, index: -1})
+				return nil
+			}
+			if err := dec.skipData(); err != nil {
+				return err
+			}
+		default:
+			return dec.raiseUnexpectedErr(dec.cursor, "string key or '}'")
+		}
+	}
+}
+
+// walkIndex moves the cursor to the element of index tok of the array being read.
+func (dec *Decoder) walkIndex(tok, ptr string) error {
+	// the index has no leading zero, "-" references the element after the last one
+	i, err := strconv.Atoi(tok)
+	if err != nil || i < 0 || tok[0] == '+' || (tok[0] == '0' && len(tok) > 1) {
+		return PointerNotFoundError(fmt.Sprintf(pointerNotFoundErrorMsg, ptr))
+	}
+	for n := 0; ; n++ {
+		if dec.r != nil {
+			dec.discard(dec.cursor)
+		}
+		switch dec.nextChar() {
+		case ']':
+			return PointerNotFoundError(fmt.Sprintf(pointerNotFoundErrorMsg, ptr))
+		case 0:
+			return dec.raiseUnexpectedErr(dec.cursor, "value")
+		}
+		if n == i {
+			dec.path = append(dec.path, pathElem{index: i})
+			return nil
+		}
+		if err := dec.skipData(); err != nil {
+			return err
+		}
+	}
+}
+
+// keyEquals reports whether the raw key read from the input, escape sequences included, is k.
+func keyEquals(raw []byte, k string) bool {
+	if bytes.IndexByte(raw, '\\') < 0 {
+		return string(raw) == k
+	}
+	s, ok := unescapeKey(raw)
+	return ok && s == k
+}
+
+// unescapeKey decodes the escape sequences of a raw key read from the input.
+// The key is decoded from a copy as the buffer must not be modified.
+func unescapeKey(raw []byte) (string, bool) {
+	kd := borrowDecoder(nil, 0)
+	defer kd.releaseBytes()
+	kd.data = append(append(append(make([]byte, 0, len(raw)+2), '"'), raw...), '"')
+	kd.length = len(kd.data)
+	var s string
+	if err := kd.decodeString(&s); err != nil {
+		return "", false
+	}
+	return strings.Clone(s), true
+}
+
+// unescapePointerToken decodes ~1 to / and ~0 to ~ in a reference token of a JSON pointer,
+// it returns false if the token has another escape sequence.
+func unescapePointerToken(tok string) (string, bool) {
+	if !strings.Contains(tok, "~") {
+		return tok, true
+	}
+	b := make([]byte, 0, len(tok))
+	for i := 0; i < len(tok); i++ {
+		if tok[i] != '~' {
+			b = append(b, tok[i])
+			continue
+		}
+		if i+1 == len(tok) || (tok[i+1] != '0' && tok[i+1] != '1') {
+			return "", false
+		}
+		if tok[i+1] == '0' {
+			b = append(b, '~')
+		} else {
+			b = append(b, '/')
+		}
+		i++
+	}
+	return string(b), true
+}
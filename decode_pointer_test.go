@@ -0,0 +1,140 @@
+package gojay
+
+import (
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPointerJSON = `{
+	"data": {
+		"skipped": {"items": [{"id": 0}], "s": "}]\"{["},
+		"items": [
+			{"id": 1, "name": "first"},
+			{"id": 2, "name": "second", "price": 1.5, "ok": true, "big": 18446744073709551615}
+		]
+	},
+	"a/b": {"m~n": "escaped"},
+	"kéy": "unicode",
+	"": "empty"
+}`
+
+func TestGetPointer(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		ptr      string
+		expected string
+	}{
+		{ptr: "/data/items/0/id", expected: `1`},
+		{ptr: "/data/items/1/name", expected: `"second"`},
+		{ptr: "/data/items/0", expected: `{"id": 1, "name": "first"}`},
+		{ptr: "/data/skipped/s", expected: `"}]\"{["`},
+		{ptr: "/a~1b/m~0n", expected: `"escaped"`},
+		{ptr: "/kéy", expected: `"unicode"`},
+		{ptr: "/", expected: `"empty"`},
+		{ptr: "", expected: testPointerJSON},
+	}
+	data := []byte(testPointerJSON)
+	for _, testCase := range testCases {
+		t.Run(testCase.ptr, func(t *testing.T) {
+			t.Parallel()
+
+			v, err := GetPointer(data, testCase.ptr)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, string(v))
+		})
+	}
+	assert.Equal(t, testPointerJSON, string(data), "data is not modified")
+}
+
+func TestGetPointerTyped(t *testing.T) {
+	t.Parallel()
+
+	data := []byte(testPointerJSON)
+
+	s, err := GetString(data, "/data/items/1/name")
+	require.NoError(t, err)
+	assert.Equal(t, "second", s)
+
+	i, err := GetInt64(data, "/data/items/1/id")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), i)
+
+	u, err := GetUint64(data, "/data/items/1/big")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(18446744073709551615), u)
+
+	f, err := GetFloat64(data, "/data/items/1/price")
+	require.NoError(t, err)
+	assert.InDelta(t, 1.5, f, 0)
+
+	b, err := GetBool(data, "/data/items/1/ok")
+	require.NoError(t, err)
+	assert.True(t, b)
+
+	n, err := GetNumber(data, "/data/items/1/big")
+	require.NoError(t, err)
+	assert.Equal(t, Number("18446744073709551615"), n)
+
+	assert.Equal(t, testPointerJSON, string(data), "data is not modified")
+
+	_, err = GetInt64(data, "/data/items/0/name")
+	var decErr *DecodeError
+	require.ErrorAs(t, err, &decErr)
+	assert.IsType(t, InvalidUnmarshalError(""), decErr.Err)
+	assert.Equal(t, strings.Index(testPointerJSON, `"first"`), decErr.Offset, "the error is located in data")
+	assert.Equal(t, "$.data.items[0].name", decErr.Path)
+}
+
+func TestGetPointerErrors(t *testing.T) {
+	t.Parallel()
+
+	data := []byte(testPointerJSON)
+	for _, ptr := range []string{"/missing", "/data/items/2", "/data/items/-", "/data/items/01", "/data/items/x", "/data/items/0/id/x"} {
+		_, err := GetPointer(data, ptr)
+		var notFound PointerNotFoundError
+		assert.ErrorAs(t, err, &notFound, ptr)
+	}
+	for _, ptr := range []string{"data", "/a~2b", "/a~"} {
+		_, err := GetPointer(data, ptr)
+		var invalid InvalidPointerError
+		assert.ErrorAs(t, err, &invalid, ptr)
+	}
+	_, err := GetPointer([]byte(`{"a": [1, 2`), "/a/3")
+	var decErr *DecodeError
+	require.ErrorAs(t, err, &decErr)
+	assert.IsType(t, InvalidJSONError(""), decErr.Err)
+	assert.Equal(t, "$.a", decErr.Path)
+}
+
+func TestDecoderDecodePointer(t *testing.T) {
+	t.Parallel()
+
+	dec := NewDecoder(iotest.OneByteReader(strings.NewReader(testPointerJSON)))
+	u := &testSliceUser{}
+	require.NoError(t, dec.DecodePointer("/data/items/1", u))
+	assert.Equal(t, &testSliceUser{id: 2, name: "second"}, u)
+
+	r := strings.NewReader(`{"skipped": [` + strings.Repeat(`"xxxxxxxxxx",`, 1000) + `"x"], "target": "found", "after": ` +
+		strings.Repeat(" ", 1<<16) + `1}`)
+	dec = BorrowDecoder(r)
+	defer dec.Release()
+	var s string
+	require.NoError(t, dec.DecodePointer("/target", &s))
+	assert.Equal(t, "found", s)
+	assert.Positive(t, r.Len(), "the input after the target is not read")
+	assert.Less(t, cap(dec.data), 4096, "the values skipped are not buffered")
+
+	dec = NewDecoder(strings.NewReader(testPointerJSON))
+	var v EmbeddedJSON
+	require.NoError(t, dec.DecodePointer("/a~1b", &v))
+	assert.Equal(t, `{"m~n": "escaped"}`, string(v))
+
+	dec = NewDecoder(strings.NewReader(testPointerJSON))
+	var notFound PointerNotFoundError
+	assert.ErrorAs(t, dec.DecodePointer("/data/nope", &v), &notFound)
+}
@@ -16,18 +16,22 @@ func init() {
 }
 
 // NewDecoder returns a new decoder.
-// It takes an io.Reader implementation as data input.
-func NewDecoder(r io.Reader) *Decoder {
-	return &Decoder{
-		called:   0,
-		cursor:   0,
-		keysDone: 0,
-		err:      nil,
-		r:        r,
-		data:     make([]byte, 4096),
-		length:   0,
-		isPooled: 0,
+// It takes an io.Reader implementation as data input and options configuring the decoder.
+func NewDecoder(r io.Reader, opts ...DecoderOption) *Decoder {
+	dec := &Decoder{
+		called:         0,
+		cursor:         0,
+		keysDone:       0,
+		err:            nil,
+		r:              r,
+		data:           make([]byte, 4096),
+		length:         0,
+		isPooled:       0,
+		maxDepth:       DefaultMaxDepth,
+		maxBigExponent: DefaultMaxBigExponent,
 	}
+	dec.applyOptions(opts)
+	return dec
 }
 
 func newDecoderPool() any {
@@ -35,14 +39,14 @@ func newDecoderPool() any {
 }
 
 // BorrowDecoder borrows a Decoder from the pool.
-// It takes an io.Reader implementation as data input.
+// It takes an io.Reader implementation as data input and options configuring the decoder.
 //
 // In order to benefit from the pool, a borrowed decoder must be released after usage.
-func BorrowDecoder(r io.Reader) *Decoder {
-	return borrowDecoder(r, 512)
+func BorrowDecoder(r io.Reader, opts ...DecoderOption) *Decoder {
+	return borrowDecoder(r, 512, opts...)
 }
 
-func borrowDecoder(r io.Reader, bufSize int) *Decoder {
+func borrowDecoder(r io.Reader, bufSize int, opts ...DecoderOption) *Decoder {
 	//nolint:forcetypeassert
 	dec := decPool.Get().(*Decoder)
 	dec.isPooled = 0
@@ -50,6 +54,7 @@ func borrowDecoder(r io.Reader, bufSize int) *Decoder {
 	if bufSize > 0 {
 		dec.data = make([]byte, bufSize)
 	}
+	dec.applyOptions(opts)
 	return dec
 }
 
@@ -65,5 +70,26 @@ func (dec *Decoder) Release() {
 	dec.r = nil
 	dec.length = 0
 	dec.data = dec.data[:0]
+	dec.useNumber = false
+	dec.depth = 0
+	dec.maxDepth = DefaultMaxDepth
+	dec.maxBigExponent = DefaultMaxBigExponent
+	dec.maxBytes = 0
+	dec.maxTokenLength = 0
+	dec.maxBufferSize = 0
+	dec.reuseBuffer = false
+	dec.strict = false
+	dec.allowUnknownKey = nil
+	dec.duplicateKeys = DuplicateKeyLastWins
+	dec.utf8Policy = UTF8Unchecked
+	dec.bytesRead = 0
+	dec.readErr = nil
+	dec.pos = inputPos{}
+	dec.posAt = 0
+	dec.escapes = dec.escapes[:0]
+	dec.path = dec.path[:0]
+	dec.tokens = dec.tokens[:0]
+	dec.valueAt = 0
+	dec.tokenPrev = 0
 	decPool.Put(dec)
 }
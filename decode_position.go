@@ -0,0 +1,205 @@
+package gojay
+
+import (
+	"bytes"
+	"strconv"
+	"unsafe"
+)
+
+// inputPos locates a byte in the input of a Decoder, line and column start at 0.
+type inputPos struct {
+	offset int
+	line   int
+	column int
+}
+
+// advance moves p after the bytes b.
+func (p *inputPos) advance(b []byte) {
+	p.offset += len(b)
+	if n := bytes.Count(b, []byte{'\n'}); n > 0 {
+		p.line += n
+		p.column = len(b) - bytes.LastIndexByte(b, '\n') - 1
+		return
+	}
+	p.column += len(b)
+}
+
+// escapeShift records an escape sequence decoded in place in the buffer,
+// the decoded bytes start at pos and the sequence was removed bytes longer.
+type escapeShift struct {
+	pos     int
+	decoded int
+	removed int
+}
+
+// pathElem is a step of the JSON path of the value being decoded,
+// index is -1 for an object key.
+// A key read from the buffer is located by start and end rather than held by key, see setKeyPath.
+type pathElem struct {
+	key   string
+	index int
+	start int
+	end   int
+}
+
+// name returns the key of the step, data being the buffer of the Decoder.
+func (e pathElem) name(data []byte) string {
+	if e.end > e.start {
+		d := data[e.start:e.end]
+		return *(*string)(unsafe.Pointer(&d))
+	}
+	return e.key
+}
+
+// position returns the position in the input of the byte at pos in the buffer,
+// accounting for discarded bytes and escape sequences decoded in place.
+func (dec *Decoder) position(pos int) inputPos {
+	if pos > dec.length {
+		pos = dec.length
+	}
+	p := dec.pos
+	start := dec.posAt
+	if pos < start {
+		// the escape sequences before posAt are forgotten, errors are located after it
+		p.offset -= start - pos
+		p.column = max(p.column-(start-pos), 0)
+		return p
+	}
+	for _, e := range dec.escapes {
+		if e.pos >= pos {
+			break
+		}
+		p.advance(dec.data[start:e.pos])
+		// escape sequences never contain a raw new line
+		n := min(e.decoded, pos-e.pos)
+		p.offset += n + e.removed
+		p.column += n + e.removed
+		start = e.pos + n
+	}
+	p.advance(dec.data[start:pos])
+	return p
+}
+
+// foldEscapes forgets the escape sequences decoded before the byte at pos of the buffer,
+// remembering its position in the input. It is called at the start of a string,
+// so that only the escape sequences of the last string are kept.
+func (dec *Decoder) foldEscapes(pos int) {
+	dec.pos = dec.position(pos)
+	dec.posAt = pos
+	dec.escapes = dec.escapes[:0]
+}
+
+// discard drops the first n bytes of the buffer, remembering their position in the input.
+func (dec *Decoder) discard(n int) {
+	if n < dec.posAt {
+		dec.posAt -= n
+	} else {
+		dec.pos = dec.position(n)
+		dec.posAt = 0
+	}
+	escapes := dec.escapes[:0]
+	for _, e := range dec.escapes {
+		if e.pos >= n {
+			e.pos -= n
+			escapes = append(escapes, e)
+		}
+	}
+	dec.escapes = escapes
+	// the keys located in the buffer are kept as strings pointing to the data discarded
+	for i, e := range dec.path {
+		if e.end > e.start {
+			dec.path[i] = pathElem{key: e.name(dec.data), index: -1}
+		}
+	}
+	dec.data = dec.data[n:]
+	dec.length -= n
+	dec.cursor -= n
+}
+
+// compact drops the values already decoded from the buffer of a Decoder reading from an io.Reader,
+// so that its memory stays proportional to the largest value rather than the whole input.
+// It is called before decoding a new top level value, it does nothing within an object or an array.
+//
+// By default the consumed data is sliced off and its memory is released by the garbage collector
+// once no string decoded from it is referenced anymore.
+// If the Decoder reuses its buffer, unread data is moved to the start of the buffer instead,
+// overwriting the strings decoded so far.
+func (dec *Decoder) compact() {
+	if dec.r == nil || dec.depth > 0 || dec.cursor == 0 {
+		return
+	}
+	if !dec.reuseBuffer {
+		dec.discard(dec.cursor)
+		return
+	}
+	buf := dec.data
+	dec.discard(dec.cursor)
+	dec.length = copy(buf, dec.data[:dec.length])
+	dec.data = buf
+}
+
+// setPath sets the step of the path at depth to e, the key or index of the value about to be decoded.
+// The steps of the path beyond depth are always dropped once decoded, e replaces the previous key or index in place.
+func (dec *Decoder) setPath(depth int, e pathElem) {
+	if depth < len(dec.path) {
+		dec.path[depth] = e
+		return
+	}
+	dec.path = append(dec.path, e)
+}
+
+// setKeyPath sets the step of the path at depth to the key found at data[start:end].
+// The key is located by its offsets, storing it as a string would cost a write barrier
+// for each key while the garbage collector runs.
+func (dec *Decoder) setKeyPath(depth, start, end int) {
+	if depth < len(dec.path) {
+		e := &dec.path[depth]
+		if e.key != "" {
+			e.key = ""
+		}
+		e.index, e.start, e.end = -1, start, end
+		return
+	}
+	dec.path = append(dec.path, pathElem{index: -1, start: start, end: end})
+}
+
+// jsonPath returns the JSON path of the value being decoded, $ being the root value.
+func (dec *Decoder) jsonPath() string {
+	return pathString(dec.path, dec.data)
+}
+
+// pathString returns the JSON path made of the steps of path, data being the buffer of the Decoder.
+func pathString(path []pathElem, data []byte) string {
+	b := make([]byte, 0, 32)
+	b = append(b, '$')
+	for _, e := range path {
+		switch k := e.name(data); {
+		case e.index >= 0:
+			b = append(b, '[')
+			b = strconv.AppendInt(b, int64(e.index), 10)
+			b = append(b, ']')
+		case isPathIdentifier(k):
+			b = append(b, '.')
+			b = append(b, k...)
+		default:
+			b = append(b, '[')
+			b = strconv.AppendQuote(b, k)
+			b = append(b, ']')
+		}
+	}
+	return string(b)
+}
+
+func isPathIdentifier(k string) bool {
+	if k == "" {
+		return false
+	}
+	for i := range len(k) {
+		c := k[i]
+		if c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (i > 0 && isDigit(c)) {
+			continue
+		}
+		return false
+	}
+	return true
+}
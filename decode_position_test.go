@@ -0,0 +1,255 @@
+package gojay
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testPositionItem struct {
+	price int
+}
+
+func (t *testPositionItem) UnmarshalJSONObject(dec *Decoder, k string) error {
+	if k == "price" {
+		return dec.Int(&t.price)
+	}
+	return nil
+}
+
+func (t *testPositionItem) NKeys() int {
+	return 1
+}
+
+type testPositionItems []*testPositionItem
+
+func (t *testPositionItems) UnmarshalJSONArray(dec *Decoder) error {
+	item := &testPositionItem{}
+	*t = append(*t, item)
+	return dec.Object(item)
+}
+
+type testPositionOrder struct {
+	id    string
+	items testPositionItems
+}
+
+func (t *testPositionOrder) UnmarshalJSONObject(dec *Decoder, k string) error {
+	switch k {
+	case "id":
+		return dec.String(&t.id)
+	case "items":
+		return dec.Array(&t.items)
+	}
+	return nil
+}
+
+func (t *testPositionOrder) NKeys() int {
+	return 0
+}
+
+func TestDecodeErrorPosition(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		json     string
+		v        func() any
+		at       string
+		path     string
+		expected string
+		found    string
+		cause    error
+	}{
+		{
+			name:     "type-mismatch-in-array",
+			json:     `{"id":"a","items":[{"price":1},{"price":2},{"price":3},{"price":"x"}]}`,
+			v:        func() any { return &testPositionOrder{} },
+			at:       `"x"`,
+			path:     "$.items[3].price",
+			expected: "*int",
+			found:    "string",
+			cause:    new(InvalidUnmarshalError),
+		},
+		{
+			name:  "syntax-error-after-escapes",
+			json:  "{\n  \"id\": \"a\\n\\\"b\\u00e9\\ud83d\\ude00\",\n  \"items\": [\n    {\"price\": 1},\n    {\"price\": 2x}\n  ]\n}",
+			v:     func() any { return &testPositionOrder{} },
+			at:    `2x}`,
+			path:  "$.items[1].price",
+			cause: new(InvalidJSONError),
+		},
+		{
+			name:  "interface-quoted-key",
+			json:  "{\"a b\": [1, {\"c\": [true, tru]}]}",
+			v:     func() any { return new(any) },
+			at:    `]}]}`,
+			path:  `$["a b"][1].c[1]`,
+			cause: new(InvalidJSONError),
+		},
+		{
+			name:  "invalid-escape-after-escaped-strings",
+			json:  "[\"\\t\\t\", {\"\\u00e9\": \"\\n\"}, \"a\\nb\\q\"]",
+			v:     func() any { return new(any) },
+			at:    `q"]`,
+			path:  `$[2]`,
+			cause: new(InvalidJSONError),
+		},
+		{
+			name:     "interface-missing-comma",
+			json:     "[\n\"\\t\"\n\"x\"]",
+			v:        func() any { return new(any) },
+			at:       `"x"`,
+			path:     `$[0]`,
+			expected: "',' or ']'",
+			found:    `'"'`,
+			cause:    new(InvalidJSONError),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := Unmarshal([]byte(testCase.json), testCase.v())
+			require.Error(t, err)
+			var decErr *DecodeError
+			require.ErrorAs(t, err, &decErr)
+			assert.ErrorAs(t, err, testCase.cause)
+			offset := strings.Index(testCase.json, testCase.at)
+			lineStart := strings.LastIndexByte(testCase.json[:offset], '\n') + 1
+			if testCase.found != "EOF" {
+				assert.Equal(t, offset, decErr.Offset, "offset")
+				assert.Equal(t, strings.Count(testCase.json[:offset], "\n")+1, decErr.Line, "line")
+				assert.Equal(t, offset-lineStart+1, decErr.Column, "column")
+			}
+			assert.Equal(t, testCase.path, decErr.Path)
+			var syntaxErr InvalidJSONError
+			if errors.As(err, &syntaxErr) {
+				// the legacy message gives the offset in the input too
+				assert.Contains(t, string(syntaxErr), "position "+strconv.Itoa(decErr.Offset))
+			}
+			if testCase.expected != "" {
+				assert.Equal(t, testCase.expected, decErr.Expected)
+				assert.Equal(t, testCase.found, decErr.Found)
+			}
+		})
+	}
+}
+
+func TestDecodeErrorStream(t *testing.T) {
+	t.Parallel()
+
+	input := "{\"testStr\":\"a\\tb\"}\n{\"testStr\":\"c\"}\n  {\"testStr\":\"d\", \"testInt\":x}\n"
+	c := ChannelStreamObjects(make(chan *testObject, 3))
+	dec := Stream.NewDecoder(strings.NewReader(input))
+	err := dec.DecodeStream(c)
+	require.Error(t, err)
+	var decErr *DecodeError
+	require.ErrorAs(t, err, &decErr)
+	assert.Equal(t, strings.IndexByte(input, 'x'), decErr.Offset)
+	assert.Equal(t, 3, decErr.Line)
+	assert.Equal(t, 29, decErr.Column)
+	assert.Equal(t, "$.testInt", decErr.Path)
+	assert.Contains(t, decErr.Error(), "position "+strconv.Itoa(decErr.Offset)+" ")
+}
+
+func TestDecodeErrorMessage(t *testing.T) {
+	t.Parallel()
+
+	err := &DecodeError{
+		Offset:   12,
+		Line:     2,
+		Column:   3,
+		Path:     "$.a[0]",
+		Expected: "':'",
+		Found:    "'x'",
+		Err:      InvalidJSONError("Invalid JSON, wrong char 'x' found at position 12"),
+	}
+	assert.Equal(
+		t,
+		"Invalid JSON, wrong char 'x' found at position 12 (line 2, column 3, offset 12, path $.a[0], expected ':', found 'x')",
+		err.Error(),
+	)
+	assert.Equal(t, InvalidJSONError("Invalid JSON, wrong char 'x' found at position 12"), errors.Unwrap(err))
+	err.Expected = ""
+	assert.Equal(
+		t,
+		"Invalid JSON, wrong char 'x' found at position 12 (line 2, column 3, offset 12, path $.a[0])",
+		err.Error(),
+	)
+}
+
+func TestDecoderCompact(t *testing.T) {
+	t.Parallel()
+
+	const n = 5000
+	line := `{"testStr":"` + strings.Repeat("a", 80) + `","testInt":%d}` + "\n"
+	input := strings.Builder{}
+	for i := range n {
+		input.WriteString(strings.Replace(line, "%d", strconv.Itoa(i), 1))
+	}
+
+	t.Run("release-consumed-data", func(t *testing.T) {
+		t.Parallel()
+
+		dec := NewDecoder(strings.NewReader(input.String()))
+		strs := make([]string, 0, n)
+		for i := range n {
+			o := &testObject{}
+			require.NoError(t, dec.Decode(o))
+			require.Equal(t, i, o.testInt)
+			strs = append(strs, o.testStr)
+			assert.LessOrEqual(t, len(dec.data), 4096)
+		}
+		// zero-copy strings decoded earlier are left untouched
+		for _, s := range strs {
+			require.Equal(t, strings.Repeat("a", 80), s)
+		}
+	})
+
+	t.Run("reuse-buffer", func(t *testing.T) {
+		t.Parallel()
+
+		dec := NewDecoder(strings.NewReader(input.String()), WithBufferReuse())
+		buf := &dec.data[0]
+		for i := range n {
+			o := &testObject{}
+			require.NoError(t, dec.Decode(o))
+			require.Equal(t, i, o.testInt)
+			require.Equal(t, strings.Repeat("a", 80), o.testStr)
+		}
+		assert.Same(t, buf, &dec.data[0])
+	})
+
+	t.Run("error-position", func(t *testing.T) {
+		t.Parallel()
+
+		dec := NewDecoder(strings.NewReader(input.String()+`{"testStr":"aé", "testInt":x}`), WithBufferReuse())
+		var err error
+		for err == nil {
+			err = dec.Decode(&testObject{})
+		}
+		var decErr *DecodeError
+		require.ErrorAs(t, err, &decErr)
+		assert.Equal(t, n+1, decErr.Line)
+		assert.Equal(t, input.Len()+strings.IndexByte(`{"testStr":"aé", "testInt":x}`, 'x'), decErr.Offset)
+	})
+}
+
+func TestDecoderEscapesFolded(t *testing.T) {
+	t.Parallel()
+
+	input := "[" + strings.Repeat(`"a\tbé", `, 1000) + `"c\nd", x]`
+	dec := NewDecoder(strings.NewReader(input))
+	var v any
+	err := dec.Decode(&v)
+	var decErr *DecodeError
+	require.ErrorAs(t, err, &decErr)
+	assert.Equal(t, strings.IndexByte(input, 'x'), decErr.Offset)
+	// only the escape sequences of the last string are kept
+	assert.Len(t, dec.escapes, 1)
+}
@@ -1,5 +1,115 @@
 package gojay
 
+import "fmt"
+
+// DecodeSlice decodes the next JSON array to the slice pointed to by s, decoding each element with decodeElem.
+// Method expressions of the Decoder can be used as decodeElem, like (*Decoder).String or (*Decoder).Float32,
+// DecodeObjectPtr decodes objects to a []*T and DecodeSliceOf nested arrays to a [][]T.
+//
+// Decoded elements are appended to the slice, a `null` leaves it untouched.
+//
+// Example:
+//
+//	var users []*user
+//	err := gojay.DecodeSlice(dec, &users, gojay.DecodeObjectPtr[user])
+func DecodeSlice[T any](dec *Decoder, s *[]T, decodeElem func(dec *Decoder, v *T) error) error {
+	if dec.nextChar() != '[' {
+		return dec.decodeNotArray(s)
+	}
+	// v is shared by the elements to allocate it once
+	var v, zero T
+	return dec.Array(DecodeArrayFunc(func(dec *Decoder) error {
+		v = zero
+		if err := decodeElem(dec, &v); err != nil {
+			return err
+		}
+		*s = append(*s, v)
+		return nil
+	}))
+}
+
+// DecodeSliceOf returns a function decoding a JSON array with DecodeSlice and decodeElem,
+// it is used to decode nested arrays.
+//
+// Example:
+//
+//	var matrix [][]float64
+//	err := gojay.DecodeSlice(dec, &matrix, gojay.DecodeSliceOf((*gojay.Decoder).Float64))
+func DecodeSliceOf[T any](decodeElem func(dec *Decoder, v *T) error) func(dec *Decoder, s *[]T) error {
+	return func(dec *Decoder, s *[]T) error {
+		return DecodeSlice(dec, s, decodeElem)
+	}
+}
+
+// DecodeFixedArray decodes the next JSON array to a, the slice of a fixed-size array,
+// decoding each element with decodeElem.
+//
+// The JSON array must have exactly len(a) elements, otherwise an InvalidUnmarshalError is returned
+// once the whole array is read. A `null` leaves a untouched.
+//
+// Example:
+//
+//	var point [3]float64
+//	err := gojay.DecodeFixedArray(dec, point[:], (*gojay.Decoder).Float64)
+func DecodeFixedArray[T any](dec *Decoder, a []T, decodeElem func(dec *Decoder, v *T) error) error {
+	if dec.nextChar() != '[' {
+		return dec.decodeNotArray(a)
+	}
+	n := 0
+	err := dec.Array(DecodeArrayFunc(func(dec *Decoder) error {
+		if n < len(a) {
+			n++
+			return decodeElem(dec, &a[n-1])
+		}
+		if n == len(a) {
+			n++
+			dec.err = dec.makeArrayLengthErr(dec.cursor, len(a), "more elements")
+		}
+		return dec.skipData()
+	}))
+	if err != nil {
+		return err
+	}
+	if n < len(a) {
+		// the error is located at the closing bracket
+		dec.err = dec.makeArrayLengthErr(dec.cursor-1, len(a), fmt.Sprintf("%d elements", n))
+	}
+	return nil
+}
+
+// decodeNotArray decodes the next value which is not an array to the slice v,
+// a `null` is skipped, other values set an InvalidUnmarshalError reporting the type of v.
+func (dec *Decoder) decodeNotArray(v any) error {
+	switch dec.nextChar() {
+	case 'n', 0:
+		// Array asserts the null and raises the error on a missing value
+		return dec.Array(DecodeArrayFunc(nil))
+	default:
+		return dec.skipInvalidUnmarshal(v)
+	}
+}
+
+// DecodeObjectPtr decodes the next JSON object to the *T pointed to by v, it is allocated if nil.
+// A `null` leaves v untouched.
+// It is meant to be used with DecodeSlice to decode a []*T.
+func DecodeObjectPtr[T any, PT interface {
+	*T
+	UnmarshalerJSONObject
+}](dec *Decoder, v **T) error {
+	if *v == nil {
+		if dec.nextChar() == 'n' {
+			dec.cursor++
+			if err := dec.assertNull(); err != nil {
+				return err
+			}
+			dec.called |= 1
+			return nil
+		}
+		*v = new(T)
+	}
+	return dec.Object(PT(*v))
+}
+
 // AddSliceString unmarshal the next JSON array of strings to the given *[]string s.
 func (dec *Decoder) AddSliceString(s *[]string) error {
 	return dec.SliceString(s)
@@ -7,16 +117,7 @@ func (dec *Decoder) AddSliceString(s *[]string) error {
 
 // SliceString unmarshal the next JSON array of strings to the given *[]string s.
 func (dec *Decoder) SliceString(s *[]string) error {
-	str := AcquireString()
-	defer ReleaseString(str)
-
-	return dec.Array(DecodeArrayFunc(func(dec *Decoder) error {
-		if err := dec.String(str); err != nil {
-			return err
-		}
-		*s = append(*s, *str)
-		return nil
-	}))
+	return DecodeSlice(dec, s, (*Decoder).String)
 }
 
 // AddSliceInt unmarshal the next JSON array of integers to the given *[]int s.
@@ -26,31 +127,47 @@ func (dec *Decoder) AddSliceInt(s *[]int) error {
 
 // SliceInt unmarshal the next JSON array of integers to the given *[]int s.
 func (dec *Decoder) SliceInt(s *[]int) error {
-	var i int
-	return dec.Array(DecodeArrayFunc(func(dec *Decoder) error {
-		if err := dec.Int(&i); err != nil {
-			return err
-		}
-		*s = append(*s, i)
-		return nil
-	}))
+	return DecodeSlice(dec, s, (*Decoder).Int)
 }
 
-// AddSliceInt8 unmarshal the next JSON array of integers to the given *[]int s.
+// AddSliceInt8 unmarshal the next JSON array of integers to the given *[]int8 s.
 func (dec *Decoder) AddSliceInt8(s *[]int8) error {
 	return dec.SliceInt8(s)
 }
 
 // SliceInt8 unmarshal the next JSON array of integers to the given *[]int8 s.
 func (dec *Decoder) SliceInt8(s *[]int8) error {
-	var i int8
-	return dec.Array(DecodeArrayFunc(func(dec *Decoder) error {
-		if err := dec.Int8(&i); err != nil {
-			return err
-		}
-		*s = append(*s, i)
-		return nil
-	}))
+	return DecodeSlice(dec, s, (*Decoder).Int8)
+}
+
+// AddSliceInt16 unmarshal the next JSON array of integers to the given *[]int16 s.
+func (dec *Decoder) AddSliceInt16(s *[]int16) error {
+	return dec.SliceInt16(s)
+}
+
+// SliceInt16 unmarshal the next JSON array of integers to the given *[]int16 s.
+func (dec *Decoder) SliceInt16(s *[]int16) error {
+	return DecodeSlice(dec, s, (*Decoder).Int16)
+}
+
+// AddSliceInt32 unmarshal the next JSON array of integers to the given *[]int32 s.
+func (dec *Decoder) AddSliceInt32(s *[]int32) error {
+	return dec.SliceInt32(s)
+}
+
+// SliceInt32 unmarshal the next JSON array of integers to the given *[]int32 s.
+func (dec *Decoder) SliceInt32(s *[]int32) error {
+	return DecodeSlice(dec, s, (*Decoder).Int32)
+}
+
+// AddSliceInt64 unmarshal the next JSON array of integers to the given *[]int64 s.
+func (dec *Decoder) AddSliceInt64(s *[]int64) error {
+	return dec.SliceInt64(s)
+}
+
+// SliceInt64 unmarshal the next JSON array of integers to the given *[]int64 s.
+func (dec *Decoder) SliceInt64(s *[]int64) error {
+	return DecodeSlice(dec, s, (*Decoder).Int64)
 }
 
 // AddSliceUint8 unmarshal the next JSON array of integers to the given *[]uint8 s.
@@ -60,14 +177,47 @@ func (dec *Decoder) AddSliceUint8(s *[]uint8) error {
 
 // SliceUint8 unmarshal the next JSON array of integers to the given *[]uint8 s.
 func (dec *Decoder) SliceUint8(s *[]uint8) error {
-	var i uint8
-	return dec.Array(DecodeArrayFunc(func(dec *Decoder) error {
-		if err := dec.Uint8(&i); err != nil {
-			return err
-		}
-		*s = append(*s, i)
-		return nil
-	}))
+	return DecodeSlice(dec, s, (*Decoder).Uint8)
+}
+
+// AddSliceUint16 unmarshal the next JSON array of integers to the given *[]uint16 s.
+func (dec *Decoder) AddSliceUint16(s *[]uint16) error {
+	return dec.SliceUint16(s)
+}
+
+// SliceUint16 unmarshal the next JSON array of integers to the given *[]uint16 s.
+func (dec *Decoder) SliceUint16(s *[]uint16) error {
+	return DecodeSlice(dec, s, (*Decoder).Uint16)
+}
+
+// AddSliceUint32 unmarshal the next JSON array of integers to the given *[]uint32 s.
+func (dec *Decoder) AddSliceUint32(s *[]uint32) error {
+	return dec.SliceUint32(s)
+}
+
+// SliceUint32 unmarshal the next JSON array of integers to the given *[]uint32 s.
+func (dec *Decoder) SliceUint32(s *[]uint32) error {
+	return DecodeSlice(dec, s, (*Decoder).Uint32)
+}
+
+// AddSliceUint64 unmarshal the next JSON array of integers to the given *[]uint64 s.
+func (dec *Decoder) AddSliceUint64(s *[]uint64) error {
+	return dec.SliceUint64(s)
+}
+
+// SliceUint64 unmarshal the next JSON array of integers to the given *[]uint64 s.
+func (dec *Decoder) SliceUint64(s *[]uint64) error {
+	return DecodeSlice(dec, s, (*Decoder).Uint64)
+}
+
+// AddSliceFloat32 unmarshal the next JSON array of floats to the given *[]float32 s.
+func (dec *Decoder) AddSliceFloat32(s *[]float32) error {
+	return dec.SliceFloat32(s)
+}
+
+// SliceFloat32 unmarshal the next JSON array of floats to the given *[]float32 s.
+func (dec *Decoder) SliceFloat32(s *[]float32) error {
+	return DecodeSlice(dec, s, (*Decoder).Float32)
 }
 
 // AddSliceFloat64 unmarshal the next JSON array of floats to the given *[]float64 s.
@@ -77,14 +227,7 @@ func (dec *Decoder) AddSliceFloat64(s *[]float64) error {
 
 // SliceFloat64 unmarshal the next JSON array of floats to the given *[]float64 s.
 func (dec *Decoder) SliceFloat64(s *[]float64) error {
-	var i float64
-	return dec.Array(DecodeArrayFunc(func(dec *Decoder) error {
-		if err := dec.Float64(&i); err != nil {
-			return err
-		}
-		*s = append(*s, i)
-		return nil
-	}))
+	return DecodeSlice(dec, s, (*Decoder).Float64)
 }
 
 // AddSliceBool unmarshal the next JSON array of bool to the given *[]bool s.
@@ -94,14 +237,7 @@ func (dec *Decoder) AddSliceBool(s *[]bool) error {
 
 // SliceBool unmarshal the next JSON array of bool to the given *[]bool s.
 func (dec *Decoder) SliceBool(s *[]bool) error {
-	var b bool
-	return dec.Array(DecodeArrayFunc(func(dec *Decoder) error {
-		if err := dec.Bool(&b); err != nil {
-			return err
-		}
-		*s = append(*s, b)
-		return nil
-	}))
+	return DecodeSlice(dec, s, (*Decoder).Bool)
 }
 
 // AddSliceStringNoEscape unmarshal the next JSON array of strings to the given *[]string s.
@@ -111,14 +247,5 @@ func (dec *Decoder) AddSliceStringNoEscape(s *[]string) error {
 
 // SliceStringNoEscape unmarshal the next JSON array of strings to the given *[]string s.
 func (dec *Decoder) SliceStringNoEscape(s *[]string) error {
-	str := AcquireString()
-	defer ReleaseString(str)
-
-	return dec.Array(DecodeArrayFunc(func(dec *Decoder) error {
-		if err := dec.StringNoEscape(str); err != nil {
-			return err
-		}
-		*s = append(*s, *str)
-		return nil
-	}))
+	return DecodeSlice(dec, s, (*Decoder).StringNoEscape)
 }
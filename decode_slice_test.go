@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -184,3 +185,162 @@ func TestDecodeSlices(t *testing.T) {
 		)
 	}
 }
+
+type testSliceUser struct {
+	id   int
+	name string
+}
+
+func (u *testSliceUser) UnmarshalJSONObject(dec *Decoder, k string) error {
+	switch k {
+	case "id":
+		return dec.Int(&u.id)
+	case "name":
+		return dec.String(&u.name)
+	}
+	return nil
+}
+
+func (u *testSliceUser) NKeys() int {
+	return 2
+}
+
+type testSlices struct {
+	int16s   []int16
+	int32s   []int32
+	int64s   []int64
+	uint16s  []uint16
+	uint32s  []uint32
+	uint64s  []uint64
+	float32s []float32
+	users    []*testSliceUser
+	matrix   [][]int
+	point    [3]float64
+}
+
+func (s *testSlices) UnmarshalJSONObject(dec *Decoder, k string) error {
+	switch k {
+	case "int16s":
+		return dec.SliceInt16(&s.int16s)
+	case "int32s":
+		return dec.AddSliceInt32(&s.int32s)
+	case "int64s":
+		return dec.SliceInt64(&s.int64s)
+	case "uint16s":
+		return dec.AddSliceUint16(&s.uint16s)
+	case "uint32s":
+		return dec.SliceUint32(&s.uint32s)
+	case "uint64s":
+		return dec.AddSliceUint64(&s.uint64s)
+	case "float32s":
+		return dec.SliceFloat32(&s.float32s)
+	case "users":
+		return DecodeSlice(dec, &s.users, DecodeObjectPtr[testSliceUser])
+	case "matrix":
+		return DecodeSlice(dec, &s.matrix, DecodeSliceOf((*Decoder).Int))
+	case "point":
+		return DecodeFixedArray(dec, s.point[:], (*Decoder).Float64)
+	}
+	return nil
+}
+
+func (s *testSlices) NKeys() int {
+	return 0
+}
+
+func TestDecodeSliceGeneric(t *testing.T) {
+	t.Parallel()
+
+	json := `{
+		"int16s": [-32767, 32767],
+		"int32s": [-2147483647, 2147483647],
+		"int64s": [-9223372036854775807, 9223372036854775807],
+		"uint16s": [65535],
+		"uint32s": [4294967295],
+		"uint64s": [18446744073709551615],
+		"float32s": [1.5, -2.25],
+		"users": [{"id": 1, "name": "a"}, null, {"id": 2}],
+		"matrix": [[1, 2], [], null, [3]],
+		"point": [1, 2.5, -3]
+	}`
+	var v testSlices
+	require.NoError(t, Unmarshal([]byte(json), &v))
+	assert.Equal(t, []int16{-32767, 32767}, v.int16s)
+	assert.Equal(t, []int32{-2147483647, 2147483647}, v.int32s)
+	assert.Equal(t, []int64{-9223372036854775807, 9223372036854775807}, v.int64s)
+	assert.Equal(t, []uint16{65535}, v.uint16s)
+	assert.Equal(t, []uint32{4294967295}, v.uint32s)
+	assert.Equal(t, []uint64{18446744073709551615}, v.uint64s)
+	assert.Equal(t, []float32{1.5, -2.25}, v.float32s)
+	assert.Equal(t, []*testSliceUser{{id: 1, name: "a"}, nil, {id: 2}}, v.users)
+	assert.Equal(t, [][]int{{1, 2}, nil, nil, {3}}, v.matrix)
+	assert.Equal(t, [3]float64{1, 2.5, -3}, v.point)
+
+	v = testSlices{int64s: []int64{1}, point: [3]float64{1, 1, 1}}
+	require.NoError(t, Unmarshal([]byte(`{"int64s": [2], "point": null, "users": null}`), &v))
+	assert.Equal(t, []int64{1, 2}, v.int64s, "elements are appended")
+	assert.Equal(t, [3]float64{1, 1, 1}, v.point, "null leaves the array untouched")
+	assert.Nil(t, v.users)
+}
+
+func TestDecodeSliceGenericErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		json     string
+		offset   int
+		path     string
+		expected string
+		found    string
+	}{
+		{
+			name:     "not an array",
+			json:     `{"int16s": {"a": 1}}`,
+			offset:   11,
+			path:     "$.int16s",
+			expected: "*[]int16",
+			found:    "object",
+		},
+		{
+			name:     "fixed array too short",
+			json:     `{"point": [1, 2]}`,
+			offset:   15,
+			path:     "$.point",
+			expected: "3 elements",
+			found:    "2 elements",
+		},
+		{
+			name:     "fixed array too long",
+			json:     `{"point": [1, 2, 3, {"a": [4]}, 5]}`,
+			offset:   20,
+			path:     "$.point[3]",
+			expected: "3 elements",
+			found:    "more elements",
+		},
+		{
+			name:     "fixed array not an array",
+			json:     `{"point": "1,2,3"}`,
+			offset:   10,
+			path:     "$.point",
+			expected: "[]float64",
+			found:    "string",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var v testSlices
+			err := Unmarshal([]byte(testCase.json), &v)
+			var decErr *DecodeError
+			require.ErrorAs(t, err, &decErr)
+			assert.IsType(t, InvalidUnmarshalError(""), decErr.Err)
+			assert.Equal(t, testCase.offset, decErr.Offset)
+			assert.Equal(t, testCase.path, decErr.Path)
+			assert.Equal(t, testCase.expected, decErr.Expected)
+			assert.Equal(t, testCase.found, decErr.Found)
+		})
+	}
+
+}
@@ -7,6 +7,7 @@ func (dec *Decoder) DecodeSQLNullString(v *sql.NullString) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	return dec.decodeSQLNullString(v)
 }
 
@@ -25,6 +26,7 @@ func (dec *Decoder) DecodeSQLNullInt64(v *sql.NullInt64) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	return dec.decodeSQLNullInt64(v)
 }
 
@@ -43,6 +45,7 @@ func (dec *Decoder) DecodeSQLNullFloat64(v *sql.NullFloat64) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	return dec.decodeSQLNullFloat64(v)
 }
 
@@ -61,6 +64,7 @@ func (dec *Decoder) DecodeSQLNullBool(v *sql.NullBool) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	return dec.decodeSQLNullBool(v)
 }
 
@@ -0,0 +1,152 @@
+package gojay
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// UnmarshalerStream is the interface to implement for a slice, an array or a channel
+// to decode a line or comma delimited JSON stream to.
+type UnmarshalerStream interface {
+	UnmarshalStream(dec *StreamDecoder) error
+}
+
+// Stream is a struct holding the Stream API.
+var Stream = stream{}
+
+type stream struct{}
+
+// A StreamDecoder reads and decodes JSON values from an input stream.
+//
+// It implements context.Context and provides a channel to notify interruption.
+type StreamDecoder struct {
+	*Decoder
+	mux      sync.RWMutex
+	done     chan struct{}
+	doneErr  error
+	deadline *time.Time
+}
+
+// DecodeStream reads the next line or comma delimited JSON-encoded value from the decoder's input (io.Reader)
+// and calls c.UnmarshalStream for each of them until the input is exhausted or the decoder is cancelled.
+//
+// c must implement UnmarshalerStream. Ideally c is a channel. See example for implementation.
+//
+// When DecodeStream returns, the Done channel is closed and Err returns the reason of the interruption,
+// nil meaning the input reached io.EOF.
+//
+// See the documentation for Unmarshal for details about the conversion of JSON into a Go value.
+func (dec *StreamDecoder) DecodeStream(c UnmarshalerStream) error {
+	if dec.isPooled == 1 {
+		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
+	}
+	if dec.r == nil {
+		err := NoReaderError("No reader given to decode stream")
+		dec.err = err
+		dec.finish(err)
+		return err
+	}
+	if dec.deadline != nil {
+		if rd, ok := dec.r.(interface{ SetReadDeadline(t time.Time) error }); ok {
+			_ = rd.SetReadDeadline(*dec.deadline)
+		}
+		timer := time.AfterFunc(time.Until(*dec.deadline), func() {
+			dec.Cancel(context.DeadlineExceeded)
+		})
+		defer timer.Stop()
+	}
+	for dec.nextChar() != 0 {
+		select {
+		case <-dec.done:
+			return dec.Err()
+		default:
+		}
+		start := dec.cursor
+		if err := c.UnmarshalStream(dec); err != nil {
+			dec.err = err
+			dec.finish(err)
+			return err
+		}
+		// if the value was not consumed we skip it to avoid looping forever on it
+		if dec.cursor == start {
+			if err := dec.skipData(); err != nil {
+				dec.finish(err)
+				return err
+			}
+		}
+		// garbage collects buffer,
+		// we don't want the buffer to grow extensively
+		dec.compact()
+	}
+	// dec.err is only set here if reading from the io.Reader failed,
+	// it is copied as the decoder may be released as soon as done is closed
+	err := dec.err
+	dec.finish(err)
+	return err
+}
+
+// Cancel interrupts the stream decoding, err is then returned by Err.
+//
+// Decoding stops before the next value of the stream is read,
+// after calling Cancel, Done() will return a closed channel.
+func (dec *StreamDecoder) Cancel(err error) {
+	dec.finish(err)
+}
+
+func (dec *StreamDecoder) finish(err error) {
+	dec.mux.Lock()
+	defer dec.mux.Unlock()
+	select {
+	case <-dec.done:
+	default:
+		dec.doneErr = err
+		close(dec.done)
+	}
+}
+
+// context.Context implementation
+
+// Done returns a channel that is closed when work is done.
+// It implements context.Context.
+func (dec *StreamDecoder) Done() <-chan struct{} {
+	return dec.done
+}
+
+// Deadline returns the time when work done on behalf of this context
+// should be canceled. Deadline returns ok==false when no deadline is
+// set. Successive calls to Deadline return the same results.
+func (dec *StreamDecoder) Deadline() (time.Time, bool) {
+	if dec.deadline != nil {
+		return *dec.deadline, true
+	}
+	return time.Time{}, false
+}
+
+// SetDeadline sets the deadline after which DecodeStream is cancelled with context.DeadlineExceeded.
+// If the io.Reader has a SetReadDeadline method (like a net.Conn), the deadline is set on it as well.
+//
+// It must be called before DecodeStream.
+func (dec *StreamDecoder) SetDeadline(t time.Time) {
+	dec.deadline = &t
+}
+
+// Err returns nil if Done is not yet closed.
+// If Done is closed, Err returns the error explaining why,
+// or nil if the whole input was decoded.
+// It implements context.Context.
+func (dec *StreamDecoder) Err() error {
+	select {
+	case <-dec.done:
+		dec.mux.RLock()
+		defer dec.mux.RUnlock()
+		return dec.doneErr
+	default:
+		return nil
+	}
+}
+
+// Value implements context.Context.
+func (dec *StreamDecoder) Value(_ any) any {
+	return nil
+}
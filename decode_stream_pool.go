@@ -0,0 +1,86 @@
+package gojay
+
+import (
+	"io"
+	"sync"
+)
+
+var streamDecPool = sync.Pool{
+	New: newStreamDecoderPool,
+}
+
+// NewDecoder returns a new StreamDecoder.
+// It takes an io.Reader implementation as data input and options configuring the decoder.
+// It initiates the done channel returned by Done().
+func (s stream) NewDecoder(r io.Reader, opts ...DecoderOption) *StreamDecoder {
+	dec := NewDecoder(r, opts...)
+	streamDec := &StreamDecoder{
+		Decoder: dec,
+		done:    make(chan struct{}),
+	}
+	return streamDec
+}
+
+func newStreamDecoderPool() any {
+	return Stream.NewDecoder(nil)
+}
+
+// BorrowDecoder borrows a StreamDecoder from the pool.
+// It takes an io.Reader implementation as data input and options configuring the decoder.
+// It initiates the done channel returned by Done().
+//
+// If no StreamDecoder is available in the pool, it returns a fresh one.
+func (s stream) BorrowDecoder(r io.Reader, opts ...DecoderOption) *StreamDecoder {
+	return s.borrowDecoder(r, 512, opts...)
+}
+
+func (s stream) borrowDecoder(r io.Reader, bufSize int, opts ...DecoderOption) *StreamDecoder {
+	//nolint:forcetypeassert
+	streamDec := streamDecPool.Get().(*StreamDecoder)
+	streamDec.called = 0
+	streamDec.keysDone = 0
+	streamDec.cursor = 0
+	streamDec.err = nil
+	streamDec.r = r
+	streamDec.length = 0
+	streamDec.isPooled = 0
+	streamDec.useNumber = false
+	streamDec.pos = inputPos{}
+	streamDec.posAt = 0
+	streamDec.escapes = streamDec.escapes[:0]
+	streamDec.path = streamDec.path[:0]
+	streamDec.tokens = streamDec.tokens[:0]
+	streamDec.valueAt = 0
+	streamDec.tokenPrev = 0
+	streamDec.depth = 0
+	streamDec.maxDepth = DefaultMaxDepth
+	streamDec.maxBigExponent = DefaultMaxBigExponent
+	streamDec.maxBytes = 0
+	streamDec.maxTokenLength = 0
+	streamDec.maxBufferSize = 0
+	streamDec.reuseBuffer = false
+	streamDec.strict = false
+	streamDec.allowUnknownKey = nil
+	streamDec.duplicateKeys = DuplicateKeyLastWins
+	streamDec.utf8Policy = UTF8Unchecked
+	streamDec.bytesRead = 0
+	streamDec.readErr = nil
+	streamDec.done = make(chan struct{})
+	streamDec.doneErr = nil
+	streamDec.deadline = nil
+	if bufSize > 0 {
+		streamDec.data = make([]byte, bufSize)
+	}
+	streamDec.applyOptions(opts)
+	return streamDec
+}
+
+// Release sends back a StreamDecoder to the pool.
+// If a decoder is used after calling Release
+// a panic will be raised with an InvalidUsagePooledDecoderError error.
+//
+// When cancelling a stream, Release must only be called once DecodeStream has returned.
+func (dec *StreamDecoder) Release() {
+	dec.isPooled = 1
+	streamDecPool.Put(dec)
+}
@@ -0,0 +1,266 @@
+package gojay
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Verify StreamDecoder implements context.Context.
+var _ context.Context = &StreamDecoder{}
+
+type ChannelStreamObjects chan *testObject
+
+func (c ChannelStreamObjects) UnmarshalStream(dec *StreamDecoder) error {
+	obj := &testObject{}
+	if err := dec.AddObject(obj); err != nil {
+		return err
+	}
+	c <- obj
+	return nil
+}
+
+type ChannelStreamStrings chan *string
+
+func (c ChannelStreamStrings) UnmarshalStream(dec *StreamDecoder) error {
+	str := ""
+	if err := dec.AddString(&str); err != nil {
+		return err
+	}
+	c <- &str
+	return nil
+}
+
+type streamErrorUnmarshaler struct{}
+
+func (s streamErrorUnmarshaler) UnmarshalStream(_ *StreamDecoder) error {
+	return errors.New("stream error")
+}
+
+type streamSkipUnmarshaler struct {
+	calls int
+}
+
+func (s *streamSkipUnmarshaler) UnmarshalStream(_ *StreamDecoder) error {
+	s.calls++
+	return nil
+}
+
+type streamErrReader struct{}
+
+func (r streamErrReader) Read(_ []byte) (int, error) {
+	return 0, errors.New("read error")
+}
+
+func collectStreamObjects(t *testing.T, dec *StreamDecoder, c ChannelStreamObjects) []*testObject {
+	t.Helper()
+
+	res := make([]*testObject, 0)
+	for {
+		select {
+		case o := <-c:
+			res = append(res, o)
+		case <-dec.Done():
+			// drain values pushed right before done was closed
+			for {
+				select {
+				case o := <-c:
+					res = append(res, o)
+				default:
+					return res
+				}
+			}
+		}
+	}
+}
+
+func TestStreamDecodingObjects(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{
+			name:     "line-delimited",
+			input:    "{\"testStr\":\"hello\"}\n{\"testStr\":\"world\"}\n",
+			expected: []string{"hello", "world"},
+		},
+		{
+			name:     "comma-delimited",
+			input:    `{"testStr":"hello"},{"testStr":"world"},{"testStr":"!"}`,
+			expected: []string{"hello", "world", "!"},
+		},
+		{
+			name:     "empty",
+			input:    "  \n\t",
+			expected: []string{},
+		},
+		{
+			name:     "large",
+			input:    strings.Repeat("{\"testStr\":\""+strings.Repeat("a", 300)+"\",\"testInt\":1}\n", 20),
+			expected: strings.Split(strings.TrimSuffix(strings.Repeat(strings.Repeat("a", 300)+",", 20), ","), ","),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := ChannelStreamObjects(make(chan *testObject, len(testCase.expected)))
+			dec := Stream.NewDecoder(strings.NewReader(testCase.input))
+			err := dec.DecodeStream(c)
+			require.NoError(t, err)
+			require.NoError(t, dec.Err())
+			res := collectStreamObjects(t, dec, c)
+			require.Len(t, res, len(testCase.expected))
+			for i, o := range res {
+				assert.Equal(t, testCase.expected[i], o.testStr)
+			}
+		})
+	}
+}
+
+func TestStreamDecodingStringsAsync(t *testing.T) {
+	t.Parallel()
+
+	c := ChannelStreamStrings(make(chan *string))
+	dec := Stream.BorrowDecoder(strings.NewReader(`"hello" "world" "!"`))
+	defer dec.Release()
+	go func() {
+		_ = dec.DecodeStream(c)
+	}()
+	res := make([]string, 0, 3)
+	for len(res) < 3 {
+		res = append(res, *<-c)
+	}
+	<-dec.Done()
+	require.NoError(t, dec.Err())
+	assert.Equal(t, []string{"hello", "world", "!"}, res)
+}
+
+func TestStreamDecodingErrors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no-reader", func(t *testing.T) {
+		t.Parallel()
+
+		dec := Stream.NewDecoder(nil)
+		err := dec.DecodeStream(ChannelStreamObjects(make(chan *testObject)))
+		require.Error(t, err)
+		require.IsType(t, NoReaderError(""), err)
+		assert.Equal(t, err, dec.Err())
+	})
+	t.Run("unmarshal-error", func(t *testing.T) {
+		t.Parallel()
+
+		dec := Stream.NewDecoder(strings.NewReader(`{"testStr":"hello"}`))
+		err := dec.DecodeStream(streamErrorUnmarshaler{})
+		require.Error(t, err)
+		assert.Equal(t, "stream error", dec.Err().Error())
+	})
+	t.Run("invalid-json", func(t *testing.T) {
+		t.Parallel()
+
+		c := ChannelStreamObjects(make(chan *testObject, 1))
+		dec := Stream.NewDecoder(strings.NewReader(`{"testStr":"hello"} {"testStr":`))
+		err := dec.DecodeStream(c)
+		require.Error(t, err)
+		require.ErrorAs(t, err, new(InvalidJSONError))
+		assert.Equal(t, err, dec.Err())
+	})
+	t.Run("reader-error", func(t *testing.T) {
+		t.Parallel()
+
+		dec := Stream.NewDecoder(streamErrReader{})
+		err := dec.DecodeStream(ChannelStreamObjects(make(chan *testObject)))
+		require.Error(t, err)
+		assert.Equal(t, "read error", dec.Err().Error())
+	})
+}
+
+func TestStreamDecodingSkipsUnconsumedValues(t *testing.T) {
+	t.Parallel()
+
+	s := &streamSkipUnmarshaler{}
+	dec := Stream.NewDecoder(strings.NewReader(`{"a":[1,2]} "b" [true] null`))
+	err := dec.DecodeStream(s)
+	require.NoError(t, err)
+	assert.Equal(t, 4, s.calls)
+}
+
+func TestStreamDecodingCancel(t *testing.T) {
+	t.Parallel()
+
+	pr, pw := io.Pipe()
+	c := ChannelStreamObjects(make(chan *testObject))
+	dec := Stream.NewDecoder(pr)
+	go func() {
+		_ = dec.DecodeStream(c)
+	}()
+	go func() {
+		_, _ = pw.Write([]byte(`{"testStr":"hello"}` + "\n"))
+		_, _ = pw.Write([]byte(`{"testStr":"world"}` + "\n"))
+	}()
+	o := <-c
+	assert.Equal(t, "hello", o.testStr)
+	cancelErr := errors.New("cancelled")
+	dec.Cancel(cancelErr)
+	<-dec.Done()
+	assert.Equal(t, cancelErr, dec.Err())
+	// cancelling twice keeps the first error
+	dec.Cancel(errors.New("other"))
+	assert.Equal(t, cancelErr, dec.Err())
+	_ = pw.Close()
+}
+
+func TestStreamDecodingDeadline(t *testing.T) {
+	t.Parallel()
+
+	pr, pw := io.Pipe()
+	defer pw.Close()
+	dec := Stream.NewDecoder(pr)
+	_, ok := dec.Deadline()
+	assert.False(t, ok)
+	deadline := time.Now().Add(20 * time.Millisecond)
+	dec.SetDeadline(deadline)
+	d, ok := dec.Deadline()
+	assert.True(t, ok)
+	assert.Equal(t, deadline, d)
+	assert.NoError(t, dec.Err())
+	go func() {
+		_ = dec.DecodeStream(ChannelStreamObjects(make(chan *testObject)))
+	}()
+	select {
+	case <-dec.Done():
+	case <-time.After(time.Second):
+		t.Fatal("deadline should have closed done channel")
+	}
+	assert.Equal(t, context.DeadlineExceeded, dec.Err())
+	assert.Nil(t, dec.Value("key"))
+}
+
+func TestStreamDecoderPool(t *testing.T) {
+	t.Parallel()
+
+	dec := Stream.NewDecoder(strings.NewReader(`"a"`))
+	dec.isPooled = 1
+	assert.PanicsWithValue(t, InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"), func() {
+		_ = dec.DecodeStream(ChannelStreamStrings(make(chan *string)))
+	})
+	dec = Stream.borrowDecoder(strings.NewReader(`"a"`), 0)
+	assert.Equal(t, 0, dec.length)
+	assert.NoError(t, dec.Err())
+	select {
+	case <-dec.Done():
+		t.Fatal("done channel of a borrowed decoder must be open")
+	default:
+	}
+	assert.IsType(t, &StreamDecoder{}, newStreamDecoderPool())
+}
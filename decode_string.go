@@ -2,7 +2,8 @@ package gojay
 
 import (
 	"bytes"
-	"io"
+	"slices"
+	"unicode/utf8"
 	"unsafe"
 )
 
@@ -13,6 +14,7 @@ func (dec *Decoder) DecodeString(v *string) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	dec.compact()
 	return dec.decodeString(v)
 }
 
@@ -94,7 +96,7 @@ func (dec *Decoder) decodeStringNull(v **string) error {
 }
 
 func (dec *Decoder) parseEscapedString() error {
-	if dec.cursor >= dec.length && !dec.read() {
+	if dec.cursor >= dec.length && !dec.readToken() {
 		return dec.raiseInvalidJSONErr(dec.cursor)
 	}
 	switch dec.data[dec.cursor] {
@@ -122,9 +124,11 @@ func (dec *Decoder) parseEscapedString() error {
 			return err
 		}
 		diff := dec.cursor - start
-		dec.data = append(append(dec.data[:start-1], str...), dec.data[dec.cursor:]...)
+		// only the data read so far is moved, the buffer may not be full
+		dec.data = append(append(dec.data[:start-1], str...), dec.data[dec.cursor:dec.length]...)
 		dec.length = len(dec.data)
 		dec.cursor += len(str) - diff - 1
+		dec.escapes = append(dec.escapes, escapeShift{pos: start - 1, decoded: len(str), removed: diff + 1 - len(str)})
 
 		return nil
 	default:
@@ -133,6 +137,7 @@ func (dec *Decoder) parseEscapedString() error {
 
 	dec.data = append(dec.data[:dec.cursor-1], dec.data[dec.cursor:]...)
 	dec.length--
+	dec.escapes = append(dec.escapes, escapeShift{pos: dec.cursor - 1, decoded: 1, removed: 1})
 
 	// Since we've lost a character, our dec.cursor offset is now
 	// 1 past the escaped character which is precisely where we
@@ -144,11 +149,31 @@ func (dec *Decoder) parseEscapedString() error {
 func (dec *Decoder) getString() (int, int, error) {
 	// extract key
 	keyStart := dec.cursor
-	// var str *Builder
-	for dec.cursor < dec.length || dec.read() {
+	dec.tokenStart = keyStart
+	if len(dec.escapes) > 0 {
+		// only the escape sequences of the string are needed to locate its errors
+		dec.foldEscapes(keyStart)
+	}
+	// a string without escape sequence in the data read so far is found at once
+	for data := dec.data[:dec.length]; dec.cursor < len(data); dec.cursor++ {
+		if c := data[dec.cursor]; c == '"' || c == '\\' {
+			break
+		}
+	}
+	for dec.cursor < dec.length || dec.readToken() {
 		switch dec.data[dec.cursor] {
 		// string found
 		case '"':
+			if err := dec.checkTokenLength(dec.cursor); err != nil {
+				return 0, 0, err
+			}
+			if dec.utf8Policy != UTF8Unchecked {
+				end, err := dec.checkUTF8(keyStart, dec.cursor)
+				if err != nil {
+					return 0, 0, err
+				}
+				dec.cursor = end
+			}
 			dec.cursor++
 			return keyStart, dec.cursor, nil
 		// slash found
@@ -168,7 +193,7 @@ func (dec *Decoder) getString() (int, int, error) {
 
 func (dec *Decoder) skipEscapedString() error {
 	start := dec.cursor
-	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
+	for ; dec.cursor < dec.length || dec.readToken(); dec.cursor++ {
 		if dec.data[dec.cursor] != '\\' {
 			d := dec.data[dec.cursor]
 			dec.cursor++
@@ -201,11 +226,15 @@ func (dec *Decoder) skipEscapedString() error {
 }
 
 func (dec *Decoder) skipString() error {
-	for dec.cursor < dec.length || dec.read() {
+	dec.tokenStart = dec.cursor
+	for dec.cursor < dec.length || dec.readToken() {
 		switch dec.data[dec.cursor] {
 		// found the closing quote
 		// let's return
 		case '"':
+			if err := dec.checkTokenLength(dec.cursor); err != nil {
+				return err
+			}
 			dec.cursor++
 			return nil
 		// solidus found start parsing an escaped string
@@ -312,26 +341,87 @@ func (dec *Decoder) decodeStringNoEscape(v *string) error {
 func (dec *Decoder) getStringNoEscape() (int, int, error) {
 	// extract key
 	keyStart := dec.cursor
-
-	next := bytes.IndexByte(dec.data[keyStart:], '"')
-	if next == -1 && dec.r != nil {
-		nextRead := make([]byte, 512)
-		n, err := dec.r.Read(nextRead)
+	dec.tokenStart = keyStart
+	if len(dec.escapes) > 0 {
+		// only the escape sequences of the string are needed to locate its errors
+		dec.foldEscapes(keyStart)
+	}
+	// look for the closing quote in the data read so far, then in newly read data
+	for searchStart := keyStart; ; {
+		if next := bytes.IndexByte(dec.data[searchStart:dec.length], '"'); next != -1 {
+			dec.cursor = searchStart + next + 1
+			break
+		}
+		searchStart = dec.length
+		if !dec.readToken() {
+			return 0, 0, dec.raiseInvalidJSONErr(dec.cursor)
+		}
+	}
+	if err := dec.checkTokenLength(dec.cursor - 1); err != nil {
+		return 0, 0, err
+	}
+	if dec.utf8Policy != UTF8Unchecked {
+		end, err := dec.checkUTF8(keyStart, dec.cursor-1)
 		if err != nil {
-			if err != io.EOF {
-				dec.err = err
-			}
+			return 0, 0, err
 		}
-		dec.data = append(dec.data, nextRead[0:n]...)
-		dec.length += n
-		next = bytes.IndexByte(dec.data[keyStart:], '"')
+		dec.cursor = end + 1
 	}
+	return keyStart, dec.cursor, dec.err
+}
 
-	if next == -1 {
-		return 0, 0, dec.raiseInvalidJSONErr(dec.cursor)
+// checkUTF8 applies the UTF-8 policy of the Decoder to the string between start and end in the buffer.
+// Invalid bytes are replaced in place, it returns the new end of the string.
+func (dec *Decoder) checkUTF8(start, end int) (int, error) {
+	s := dec.data[start:end]
+	if utf8.Valid(s) {
+		return end, nil
 	}
-
-	dec.cursor = keyStart + next + 1
-
-	return keyStart, dec.cursor, dec.err
+	if dec.utf8Policy == UTF8Reject {
+		i := 0
+		for {
+			r, size := utf8.DecodeRune(s[i:])
+			if r == utf8.RuneError && size == 1 {
+				return 0, dec.raiseInvalidUTF8Err(start+i, invalidUTF8ErrorMsg, "UTF-8 character")
+			}
+			i += size
+		}
+	}
+	// replace each invalid byte with U+FFFD like encoding/json, the string grows by 2 bytes per invalid byte
+	var invalid []int
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRune(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			invalid = append(invalid, start+i)
+		}
+		i += size
+	}
+	grow := 2 * len(invalid)
+	dec.data = slices.Grow(dec.data[:dec.length], grow)[:dec.length+grow]
+	copy(dec.data[end+grow:], dec.data[end:dec.length])
+	// the string is rewritten in place from its end, the bytes before an invalid byte being moved after the ones after it
+	to, from := end+grow, end
+	for k := len(invalid) - 1; k >= 0; k-- {
+		i := invalid[k]
+		to -= copy(dec.data[to-(from-i-1):to], dec.data[i+1:from])
+		to -= copy(dec.data[to-3:to], string(utf8.RuneError))
+		from = i
+	}
+	// the escape sequences of the string decoded after an invalid byte are moved as well,
+	// each replacement is recorded as a 3 bytes sequence decoded from a single byte
+	for j, e := range dec.escapes {
+		n, _ := slices.BinarySearch(invalid, e.pos)
+		dec.escapes[j].pos += 2 * n
+	}
+	for k, i := range invalid {
+		dec.escapes = append(dec.escapes, escapeShift{pos: i + 2*k, decoded: 3, removed: -2})
+	}
+	slices.SortFunc(dec.escapes, func(a, b escapeShift) int {
+		return a.pos - b.pos
+	})
+	dec.length += grow
+	if dec.cursor >= end {
+		dec.cursor += grow
+	}
+	return end + grow, nil
 }
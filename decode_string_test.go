@@ -172,7 +172,7 @@ func TestDecoderString(t *testing.T) {
 		{
 			name:           "utf16-surrogate",
 			json:           `"\uD834\uD834"`,
-			expectedResult: "�\x00\x00\x00",
+			expectedResult: "��",
 			err:            false,
 		},
 		{
@@ -281,21 +281,21 @@ func TestDecoderString(t *testing.T) {
 			json:           `"test string \\\" escaped`,
 			expectedResult: ``,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "escape quote err",
 			json:           `"test string \\\l escaped"`,
 			expectedResult: ``,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "invalid-json",
 			json:           `invalid`,
 			expectedResult: ``,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "string-complex",
@@ -314,7 +314,7 @@ func TestDecoderString(t *testing.T) {
 			if testCase.err {
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(t, testCase.errType, err, "err should of the given type")
+					assert.ErrorAs(t, err, testCase.errType, "err should of the given type")
 				}
 			} else {
 				require.NoError(t, err)
@@ -495,7 +495,7 @@ func TestDecoderStringNull(t *testing.T) {
 		{
 			name:           "utf16-surrogate",
 			json:           `"\uD834\uD834"`,
-			expectedResult: "�\x00\x00\x00",
+			expectedResult: "��",
 			err:            false,
 		},
 		{
@@ -605,21 +605,21 @@ func TestDecoderStringNull(t *testing.T) {
 			json:           `"test string \\\" escaped`,
 			expectedResult: ``,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "escape quote err",
 			json:           `"test string \\\l escaped"`,
 			expectedResult: ``,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "invalid-json",
 			json:           `invalid`,
 			expectedResult: ``,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "string-complex",
@@ -637,7 +637,7 @@ func TestDecoderStringNull(t *testing.T) {
 			if testCase.err {
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(t, testCase.errType, err, "err should of the given type")
+					assert.ErrorAs(t, err, testCase.errType, "err should of the given type")
 				}
 				return
 			}
@@ -662,7 +662,7 @@ func TestDecoderStringNull(t *testing.T) {
 		dec := NewDecoder(strings.NewReader(`a`))
 		err := dec.StringNull(&v)
 		require.Error(t, err)
-		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		assert.ErrorAs(t, err, new(InvalidJSONError), "err should be of type InvalidJSONError")
 	})
 }
 
@@ -950,7 +950,7 @@ func TestDecoderStringNoEscape(t *testing.T) {
 			json:           `invalid`,
 			expectedResult: ``,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "string-complex",
@@ -969,7 +969,7 @@ func TestDecoderStringNoEscape(t *testing.T) {
 			if testCase.err {
 				require.Error(t, err)
 				if testCase.errType != nil {
-					assert.IsType(t, testCase.errType, err, "err should of the given type")
+					assert.ErrorAs(t, err, testCase.errType, "err should of the given type")
 				}
 			} else {
 				require.NoError(t, err)
@@ -992,7 +992,7 @@ func TestDecoderStringInvalidType(t *testing.T) {
 	var v string
 	err := Unmarshal(json, &v)
 	require.Error(t, err, "Err must not be nil as JSON is invalid")
-	assert.IsType(t, InvalidUnmarshalError(""), err, "err message must be 'Invalid JSON'")
+	assert.ErrorAs(t, err, new(InvalidUnmarshalError), "err message must be 'Invalid JSON'")
 }
 
 func TestDecoderStringDecoderAPI(t *testing.T) {
@@ -1034,7 +1034,7 @@ func TestDecoderSkipEscapedStringError(t *testing.T) {
 	defer dec.Release()
 	err := dec.skipEscapedString()
 	require.Error(t, err, "Err must be nil")
-	assert.IsType(t, InvalidJSONError(""), err, "err must be of type InvalidJSONError")
+	assert.ErrorAs(t, err, new(InvalidJSONError), "err must be of type InvalidJSONError")
 }
 
 func TestDecoderSkipEscapedStringError2(t *testing.T) {
@@ -1044,7 +1044,7 @@ func TestDecoderSkipEscapedStringError2(t *testing.T) {
 	defer dec.Release()
 	err := dec.skipEscapedString()
 	require.Error(t, err, "Err must be nil")
-	assert.IsType(t, InvalidJSONError(""), err, "err must be of type InvalidJSONError")
+	assert.ErrorAs(t, err, new(InvalidJSONError), "err must be of type InvalidJSONError")
 }
 
 func TestDecoderSkipEscapedStringError3(t *testing.T) {
@@ -1054,7 +1054,7 @@ func TestDecoderSkipEscapedStringError3(t *testing.T) {
 	defer dec.Release()
 	err := dec.skipEscapedString()
 	require.Error(t, err, "Err must be nil")
-	assert.IsType(t, InvalidJSONError(""), err, "err must be of type InvalidJSONError")
+	assert.ErrorAs(t, err, new(InvalidJSONError), "err must be of type InvalidJSONError")
 }
 
 func TestDecoderSkipEscapedStringError4(t *testing.T) {
@@ -1064,7 +1064,7 @@ func TestDecoderSkipEscapedStringError4(t *testing.T) {
 	defer dec.Release()
 	err := dec.skipEscapedString()
 	require.Error(t, err, "Err must be nil")
-	assert.IsType(t, InvalidJSONError(""), err, "err must be of type InvalidJSONError")
+	assert.ErrorAs(t, err, new(InvalidJSONError), "err must be of type InvalidJSONError")
 }
 
 func TestDecoderSkipStringError(t *testing.T) {
@@ -1074,7 +1074,7 @@ func TestDecoderSkipStringError(t *testing.T) {
 	defer dec.Release()
 	err := dec.skipString()
 	require.Error(t, err, "Err must be nil")
-	assert.IsType(t, InvalidJSONError(""), err, "err must be of type InvalidJSONError")
+	assert.ErrorAs(t, err, new(InvalidJSONError), "err must be of type InvalidJSONError")
 }
 
 func TestSkipString(t *testing.T) {
@@ -1092,14 +1092,14 @@ func TestSkipString(t *testing.T) {
 			json:           `test string \\" escaped"`,
 			expectedResult: ``,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "escape quote err",
 			json:           `test string \\\l escaped"`,
 			expectedResult: ``,
 			err:            true,
-			errType:        InvalidJSONError(""),
+			errType:        new(InvalidJSONError),
 		},
 		{
 			name:           "string-solidus",
@@ -1121,10 +1121,10 @@ func TestSkipString(t *testing.T) {
 		if testCase.err {
 			require.Error(t, err)
 			if testCase.errType != nil {
-				assert.IsType(
+				assert.ErrorAs(
 					t,
-					testCase.errType,
 					err,
+					testCase.errType,
 					"err should be of expected type",
 				)
 			}
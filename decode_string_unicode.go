@@ -8,7 +8,7 @@ import (
 func (dec *Decoder) getUnicode() (rune, error) {
 	i := 0
 	r := rune(0)
-	for ; (dec.cursor < dec.length || dec.read()) && i < 4; dec.cursor++ {
+	for ; (dec.cursor < dec.length || dec.readToken()) && i < 4; dec.cursor++ {
 		c := dec.data[dec.cursor]
 		if c >= '0' && c <= '9' {
 			r = r*16 + rune(c-'0')
@@ -17,83 +17,63 @@ func (dec *Decoder) getUnicode() (rune, error) {
 		} else if c >= 'A' && c <= 'F' {
 			r = r*16 + rune(c-'A'+10)
 		} else {
-			return 0, InvalidJSONError("Invalid unicode code point")
+			return 0, dec.makeDecodeErr(InvalidJSONError("Invalid unicode code point"), dec.cursor, "hexadecimal digit", dec.foundChar(dec.cursor))
 		}
 		i++
 	}
 	return r, nil
 }
 
-func (dec *Decoder) appendEscapeChar(str []byte, c byte) ([]byte, error) {
-	switch c {
-	case 't':
-		str = append(str, '\t')
-	case 'n':
-		str = append(str, '\n')
-	case 'r':
-		str = append(str, '\r')
-	case 'b':
-		str = append(str, '\b')
-	case 'f':
-		str = append(str, '\f')
-	case '\\':
-		str = append(str, '\\')
-	default:
-		return nil, InvalidJSONError("Invalid JSON")
-	}
-	return str, nil
-}
-
+// parseUnicode decodes the escape sequence of a code point starting after \u, and of its low surrogate
+// following it if it is a high surrogate, to UTF-8.
+// An unpaired surrogate is replaced with U+FFFD unless the UTF-8 policy of the Decoder rejects it.
 func (dec *Decoder) parseUnicode() ([]byte, error) {
-	// get unicode after u
+	// the escape sequence starts with the backslash before the u
+	escStart := dec.cursor - 2
 	r, err := dec.getUnicode()
 	if err != nil {
 		return nil, err
 	}
-	// no error start making new string
-	str := make([]byte, 16)
-	i := 0
-	// check if code can be a surrogate utf16
-	//nolint:nestif
 	if utf16.IsSurrogate(r) {
-		if dec.cursor >= dec.length && !dec.read() {
-			return nil, dec.raiseInvalidJSONErr(dec.cursor)
-		}
-		c := dec.data[dec.cursor]
-		if c != '\\' {
-			i += utf8.EncodeRune(str, r)
-			return str[:i], nil
-		}
-		dec.cursor++
-		if dec.cursor >= dec.length && !dec.read() {
-			return nil, dec.raiseInvalidJSONErr(dec.cursor)
-		}
-		c = dec.data[dec.cursor]
-		if c != 'u' {
-			i += utf8.EncodeRune(str, r)
-			str, err = dec.appendEscapeChar(str[:i], c)
-			if err != nil {
-				dec.err = err
-				return nil, err
-			}
-			i++
-			dec.cursor++
-			return str[:i], nil
-		}
-		dec.cursor++
-		r2, err := dec.getUnicode()
+		r2, ok, err := dec.getLowSurrogate(r)
 		if err != nil {
 			return nil, err
 		}
-		combined := utf16.DecodeRune(r, r2)
-		if combined == '\uFFFD' {
-			i += utf8.EncodeRune(str, r)
-			i += utf8.EncodeRune(str, r2)
-		} else {
-			i += utf8.EncodeRune(str, combined)
+		if ok {
+			return utf8.AppendRune(nil, utf16.DecodeRune(r, r2)), nil
 		}
-		return str[:i], nil
+		if dec.utf8Policy == UTF8Reject {
+			return nil, dec.raiseInvalidUTF8Err(escStart, "Unpaired surrogate escape sequence", "surrogate pair")
+		}
+		r = utf8.RuneError
+	}
+	return utf8.AppendRune(nil, r), nil
+}
+
+// getLowSurrogate decodes the escape sequence of the low surrogate following the high surrogate r.
+// If there is none, the cursor is left at the start of the next escape sequence if any,
+// so that it is decoded on its own.
+func (dec *Decoder) getLowSurrogate(r rune) (rune, bool, error) {
+	if r >= 0xdc00 {
+		// a low surrogate can't start a pair
+		return 0, false, nil
+	}
+	for dec.length-dec.cursor < 2 {
+		if !dec.readToken() {
+			return 0, false, nil
+		}
+	}
+	if dec.data[dec.cursor] != '\\' || dec.data[dec.cursor+1] != 'u' {
+		return 0, false, nil
+	}
+	dec.cursor += 2
+	r2, err := dec.getUnicode()
+	if err != nil {
+		return 0, false, err
+	}
+	if r2 < 0xdc00 || r2 > 0xdfff {
+		dec.cursor -= 6
+		return 0, false, nil
 	}
-	i += utf8.EncodeRune(str, r)
-	return str[:i], nil
+	return r2, true, nil
 }
@@ -444,7 +444,7 @@ func allTypesTestCases() []allTypeDecodeTestCase {
 			name: "test decode invalid type",
 			expectations: func(err error, v any, t *testing.T) {
 				require.Error(t, err, "err must not be nil")
-				assert.IsType(t, InvalidUnmarshalError(""), err, "err must be of type InvalidUnmarshalError")
+				assert.ErrorAs(t, err, new(InvalidUnmarshalError), "err must be of type InvalidUnmarshalError")
 				assert.Equal(t, fmt.Sprintf(invalidUnmarshalErrorMsg, v), err.Error(), "err message should be equal to invalidUnmarshalErrorMsg")
 			},
 		},
@@ -525,7 +525,7 @@ func TestUnmarshalJSONObjects(t *testing.T) {
 			name: "test decode object null",
 			expectations: func(err error, _ any, t *testing.T) {
 				require.Error(t, err, "err must not be nil")
-				assert.IsType(t, InvalidJSONError(""), err, "err must be of type InvalidJSONError")
+				assert.ErrorAs(t, err, new(InvalidJSONError), "err must be of type InvalidJSONError")
 			},
 		},
 	}
@@ -0,0 +1,328 @@
+package gojay
+
+import (
+	"io"
+	"unsafe"
+)
+
+// Kind is the kind of a JSON token read by Token.
+type Kind byte
+
+const (
+	// KindNone is returned by PeekKind at the end of the input or before invalid input.
+	KindNone Kind = iota
+	KindObjectStart
+	KindObjectEnd
+	KindArrayStart
+	KindArrayEnd
+	KindKey
+	KindString
+	KindNumber
+	KindBool
+	KindNull
+)
+
+var kindNames = [...]string{
+	KindNone:        "none",
+	KindObjectStart: "object start",
+	KindObjectEnd:   "object end",
+	KindArrayStart:  "array start",
+	KindArrayEnd:    "array end",
+	KindKey:         "key",
+	KindString:      "string",
+	KindNumber:      "number",
+	KindBool:        "bool",
+	KindNull:        "null",
+}
+
+func (k Kind) String() string {
+	if int(k) < len(kindNames) {
+		return kindNames[k]
+	}
+	return "unknown"
+}
+
+// Token is a JSON token read by Decoder.Token.
+type Token struct {
+	Kind Kind
+	// Value is the decoded string of a key or a string, the literal of a number
+	// and true or false for a bool. It is empty for the other kinds.
+	Value string
+}
+
+// Number returns the value of a number token.
+func (t Token) Number() Number {
+	return Number(t.Value)
+}
+
+// Bool returns the value of a bool token.
+func (t Token) Bool() bool {
+	return t.Value == "true"
+}
+
+// tokenFrame is an object or an array opened by Token,
+// depth is the length of the path of the Decoder when it was opened and n the number of values read in an array.
+type tokenFrame struct {
+	kind  byte
+	depth int
+	n     int
+}
+
+// Token returns the next JSON token of the input, or io.EOF once the input is exhausted.
+// Several top level values can be read in a row.
+//
+// Tokens are read incrementally from the io.Reader of the Decoder, only the token being read is buffered.
+// Commas and colons are consumed but not returned, the syntax of the input is checked as tokens are read,
+// including a missing, doubled, leading or trailing comma, and errors are *DecodeError locating the invalid token.
+// Top level values are separated by spaces only.
+//
+// The Value of a token is not copied, like a string decoded by String it points to the buffer of the Decoder.
+// With WithBufferReuse it is only valid until the next top level value is read.
+//
+// Token can be mixed with the other decoding methods: after a key, or within an array,
+// the next value can be decoded with Object, Array or Interface for example, Token then reads the following key or value.
+//
+//nolint:cyclop,funlen
+func (dec *Decoder) Token() (Token, error) {
+	if dec.isPooled == 1 {
+		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
+	}
+	if dec.err != nil {
+		return Token{}, dec.err
+	}
+	if dec.valueAt != 0 && dec.cursor != dec.valueAt {
+		// the value of the last key was decoded by another method
+		dec.valueAt = 0
+	}
+	if dec.valueAt == 0 {
+		dec.discardTokens()
+	}
+	c := dec.skipSpaces()
+	var frame *tokenFrame
+	if len(dec.tokens) > 0 {
+		frame = &dec.tokens[len(dec.tokens)-1]
+	}
+	if frame != nil && frame.kind == '{' && dec.valueAt == 0 {
+		return dec.tokenKey(c)
+	}
+	dec.valueAt = 0
+	if frame != nil && frame.kind == '[' {
+		var err error
+		if c, err = dec.tokenComma(c, ']', "value"); err != nil {
+			return Token{}, err
+		}
+		if c != ']' && c != 0 {
+			dec.path = append(dec.path[:frame.depth], pathElem{index: frame.n})
+			frame.n++
+		}
+	}
+	switch c {
+	case '{', '[':
+		dec.depth++
+		if err := dec.checkDepth(dec.depth, dec.cursor); err != nil {
+			return Token{}, err
+		}
+		dec.tokens = append(dec.tokens, tokenFrame{kind: c, depth: len(dec.path)})
+		dec.cursor++
+		if c == '{' {
+			return Token{Kind: KindObjectStart}, nil
+		}
+		return Token{Kind: KindArrayStart}, nil
+	case ']':
+		if frame == nil || frame.kind != '[' {
+			return Token{}, dec.raiseUnexpectedErr(dec.cursor, "value")
+		}
+		dec.cursor++
+		dec.closeToken()
+		return Token{Kind: KindArrayEnd}, nil
+	case '"':
+		dec.cursor++
+		start, end, err := dec.getString()
+		if err != nil {
+			return Token{}, err
+		}
+		d := dec.data[start : end-1]
+		return Token{Kind: KindString, Value: *(*string)(unsafe.Pointer(&d))}, nil
+	case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		start, end, err := dec.getNumber()
+		if err != nil {
+			return Token{}, err
+		}
+		d := dec.data[start:end]
+		return Token{Kind: KindNumber, Value: *(*string)(unsafe.Pointer(&d))}, nil
+	case 't':
+		return Token{Kind: KindBool, Value: "true"}, dec.validateLiteral("true")
+	case 'f':
+		return Token{Kind: KindBool, Value: "false"}, dec.validateLiteral("false")
+	case 'n':
+		return Token{Kind: KindNull}, dec.validateLiteral("null")
+	case 0:
+		if frame != nil || dec.readErr != nil {
+			return Token{}, dec.raiseUnexpectedErr(dec.cursor, "value")
+		}
+		return Token{}, io.EOF
+	default:
+		return Token{}, dec.raiseUnexpectedErr(dec.cursor, "value")
+	}
+}
+
+// tokenKey reads the next key of an object opened by Token, or the end of the object, c being the next char.
+func (dec *Decoder) tokenKey(c byte) (Token, error) {
+	c, err := dec.tokenComma(c, '}', "string key")
+	if err != nil {
+		return Token{}, err
+	}
+	switch c {
+	case '}':
+		dec.cursor++
+		dec.closeToken()
+		return Token{Kind: KindObjectEnd}, nil
+	case '"':
+		dec.cursor++
+		start, end, err := dec.getString()
+		if err != nil {
+			return Token{}, err
+		}
+		d := dec.data[start : end-1]
+		k := *(*string)(unsafe.Pointer(&d))
+		dec.path = append(dec.path[:dec.tokens[len(dec.tokens)-1].depth], pathElem{key: k, index: -1})
+		if dec.skipSpaces() != ':' {
+			return Token{}, dec.raiseUnexpectedErr(dec.cursor, "':'")
+		}
+		dec.cursor++
+		// the value starts at the next char, a value decoded by another method moves the cursor past it
+		dec.skipSpaces()
+		dec.valueAt = dec.cursor
+		return Token{Kind: KindKey, Value: k}, nil
+	default:
+		return Token{}, dec.raiseUnexpectedErr(dec.cursor, "string key or '}'")
+	}
+}
+
+// tokenComma checks the separator before the next element of the object or array opened by Token,
+// c being the next char, end the char closing the object or array and expected what an element starts with.
+// The comma following an element is consumed and the next char is returned.
+func (dec *Decoder) tokenComma(c, end byte, expected string) (byte, error) {
+	if p := dec.prevTokenByte(); p == '{' || p == '[' {
+		// first element, no comma before it
+		if c == ',' {
+			return 0, dec.raiseUnexpectedErr(dec.cursor, expected+" or '"+string(end)+"'")
+		}
+		return c, nil
+	}
+	switch c {
+	case end:
+		return c, nil
+	case ',':
+		dec.cursor++
+		if c = dec.skipSpaces(); c == ',' || c == end {
+			return 0, dec.raiseUnexpectedErr(dec.cursor, expected)
+		}
+		return c, nil
+	default:
+		return 0, dec.raiseUnexpectedErr(dec.cursor, "',' or '"+string(end)+"'")
+	}
+}
+
+// prevTokenByte returns the last char before the cursor which is not a space,
+// it tells whether the last element of the object or array opened by Token was read, even by another method.
+// The data discarded by Token is not in the buffer anymore, its last char is remembered.
+func (dec *Decoder) prevTokenByte() byte {
+	for i := dec.cursor - 1; i >= 0; i-- {
+		switch c := dec.data[i]; c {
+		case ' ', '\n', '\t', '\r':
+		default:
+			return c
+		}
+	}
+	return dec.tokenPrev
+}
+
+// closeToken closes the last object or array opened by Token.
+func (dec *Decoder) closeToken() {
+	dec.path = dec.path[:dec.tokens[len(dec.tokens)-1].depth]
+	dec.tokens = dec.tokens[:len(dec.tokens)-1]
+	dec.depth--
+}
+
+// discardTokens drops the tokens already read from the buffer of a Decoder reading from an io.Reader.
+// Contrary to compact, it also works within an object or an array so that reading a large value
+// token by token doesn't buffer it, unless the buffer is reused as the keys of the path point to it.
+func (dec *Decoder) discardTokens() {
+	if dec.cursor > 0 {
+		dec.tokenPrev = dec.prevTokenByte()
+	}
+	if dec.reuseBuffer || len(dec.tokens) == 0 {
+		dec.compact()
+		return
+	}
+	if dec.r != nil && dec.cursor > 0 {
+		dec.discard(dec.cursor)
+	}
+}
+
+// PeekKind returns the kind of the next token without consuming it,
+// or KindNone at the end of the input or if the next char cannot start a token.
+//
+//nolint:cyclop
+func (dec *Decoder) PeekKind() Kind {
+	c := dec.skipSpaces()
+	inObject := len(dec.tokens) > 0 && dec.tokens[len(dec.tokens)-1].kind == '{'
+	isKey := inObject && (dec.valueAt == 0 || dec.cursor != dec.valueAt)
+	if c == ',' && (isKey || (len(dec.tokens) > 0 && !inObject)) {
+		// the kind is the one of the token following the comma, which must follow an element
+		if p := dec.prevTokenByte(); p == '{' || p == '[' {
+			return KindNone
+		}
+		if c = dec.peekPastComma(); c == ',' || c == '}' || c == ']' {
+			return KindNone
+		}
+	}
+	if isKey {
+		switch c {
+		case '"':
+			return KindKey
+		case '}':
+			return KindObjectEnd
+		default:
+			return KindNone
+		}
+	}
+	switch c {
+	case '{':
+		return KindObjectStart
+	case '[':
+		return KindArrayStart
+	case ']':
+		return KindArrayEnd
+	case '"':
+		return KindString
+	case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		return KindNumber
+	case 't', 'f':
+		return KindBool
+	case 'n':
+		return KindNull
+	default:
+		return KindNone
+	}
+}
+
+// More reports whether there is another element in the current array or object,
+// or another top level value at the top level.
+func (dec *Decoder) More() bool {
+	c := dec.skipSpaces()
+	return c != 0 && c != ']' && c != '}'
+}
+
+// peekPastComma returns the first char after the comma at the cursor which is not a space, without consuming them.
+func (dec *Decoder) peekPastComma() byte {
+	for i := dec.cursor + 1; i < dec.length || dec.read(); i++ {
+		switch dec.data[i] {
+		case ' ', '\n', '\t', '\r':
+			continue
+		}
+		return dec.data[i]
+	}
+	return 0
+}
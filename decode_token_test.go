@@ -0,0 +1,371 @@
+package gojay
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readTokens reads all the tokens of dec and formats them as kind:value.
+func readTokens(t *testing.T, dec *Decoder) []string {
+	t.Helper()
+
+	var tokens []string
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			return tokens
+		}
+		require.NoError(t, err)
+		tokens = append(tokens, fmt.Sprintf("%s:%s", tok.Kind, tok.Value))
+	}
+}
+
+func TestDecoderToken(t *testing.T) {
+	t.Parallel()
+
+	json := ` {"a": [1, -2.5e3, "x\"y", true, false, null], "b": {}, "c": [[]], "é": {"d": {"e": "f"}}}
+		"next" 12 `
+	expected := []string{
+		"object start:", "key:a", "array start:", "number:1", "number:-2.5e3", `string:x"y`,
+		"bool:true", "bool:false", "null:", "array end:",
+		"key:b", "object start:", "object end:",
+		"key:c", "array start:", "array start:", "array end:", "array end:",
+		"key:é", "object start:", "key:d", "object start:", "key:e", "string:f", "object end:", "object end:",
+		"object end:", "string:next", "number:12",
+	}
+
+	dec := NewDecoder(strings.NewReader(json))
+	assert.Equal(t, expected, readTokens(t, dec))
+	_, err := dec.Token()
+	assert.ErrorIs(t, err, io.EOF, "EOF is returned again")
+
+	dec = NewDecoder(iotest.OneByteReader(strings.NewReader(json)))
+	assert.Equal(t, expected, readTokens(t, dec), "tokens are read incrementally")
+
+	dec = BorrowDecoder(nil)
+	defer dec.Release()
+	dec.data = []byte(json)
+	dec.length = len(json)
+	assert.Equal(t, expected, readTokens(t, dec))
+}
+
+func TestDecoderTokenValues(t *testing.T) {
+	t.Parallel()
+
+	dec := NewDecoder(strings.NewReader(`[12345678901234567890, true, false]`))
+	_, err := dec.Token()
+	require.NoError(t, err)
+	tok, err := dec.Token()
+	require.NoError(t, err)
+	assert.Equal(t, Number("12345678901234567890"), tok.Number())
+	tok, err = dec.Token()
+	require.NoError(t, err)
+	assert.True(t, tok.Bool())
+	tok, err = dec.Token()
+	require.NoError(t, err)
+	assert.False(t, tok.Bool())
+}
+
+func TestDecoderTokenBuffer(t *testing.T) {
+	t.Parallel()
+
+	n := 10000
+	b := strings.Builder{}
+	b.WriteString(`{"items": [`)
+	for i := range n {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		fmt.Fprintf(&b, `{"id": %d, "name": "item %d"}`, i, i)
+	}
+	b.WriteString(`]}`)
+
+	dec := BorrowDecoder(strings.NewReader(b.String()))
+	defer dec.Release()
+	sum := 0
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		if tok.Kind == KindNumber {
+			id, err := tok.Number().Int64()
+			require.NoError(t, err)
+			sum += int(id)
+		}
+	}
+	assert.Equal(t, n*(n-1)/2, sum)
+	assert.Less(t, cap(dec.data), 4096, "the whole input is not buffered")
+}
+
+func TestDecoderPeekKindMore(t *testing.T) {
+	t.Parallel()
+
+	dec := NewDecoder(strings.NewReader(`{"a": [1, "b", null], "c": {"d": true}}`))
+	var kinds []Kind
+	var more []bool
+	for {
+		kind := dec.PeekKind()
+		if kind == KindNone {
+			break
+		}
+		kinds = append(kinds, kind)
+		more = append(more, dec.More())
+		tok, err := dec.Token()
+		require.NoError(t, err)
+		assert.Equal(t, kind, tok.Kind)
+	}
+	assert.Equal(
+		t,
+		[]Kind{
+			KindObjectStart, KindKey, KindArrayStart, KindNumber, KindString, KindNull, KindArrayEnd,
+			KindKey, KindObjectStart, KindKey, KindBool, KindObjectEnd, KindObjectEnd,
+		},
+		kinds,
+	)
+	assert.Equal(
+		t,
+		[]bool{true, true, true, true, true, true, false, true, true, true, true, false, false},
+		more,
+	)
+	_, err := dec.Token()
+	assert.ErrorIs(t, err, io.EOF)
+
+	for _, input := range []string{`[,1]`, `[1,]`, `[1,,2]`, `{"a":1,}`, `{"a":,1}`, `1,2`} {
+		dec = NewDecoder(strings.NewReader(input))
+		for dec.PeekKind() != KindNone {
+			_, err = dec.Token()
+			require.NoError(t, err, input)
+		}
+		_, err = dec.Token()
+		assert.Error(t, err, "%s: misplaced commas are not skipped", input)
+	}
+}
+
+func TestDecoderTokenMixed(t *testing.T) {
+	t.Parallel()
+
+	dec := NewDecoder(strings.NewReader(`{"skip": {"x": [1, {"y": 2}]}, "user": {"id": 1, "name": "a"}, "n": 3}`))
+	var values []any
+	for dec.More() {
+		tok, err := dec.Token()
+		require.NoError(t, err)
+		if tok.Kind != KindKey {
+			continue
+		}
+		switch tok.Value {
+		case "skip":
+			var v any
+			require.NoError(t, dec.Interface(&v))
+		case "user":
+			u := &testSliceUser{}
+			require.NoError(t, dec.Object(u))
+			values = append(values, u)
+		default:
+			tok, err = dec.Token()
+			require.NoError(t, err)
+			values = append(values, tok.Value)
+		}
+	}
+	assert.Equal(t, []any{&testSliceUser{id: 1, name: "a"}, "3"}, values)
+	tok, err := dec.Token()
+	require.NoError(t, err)
+	assert.Equal(t, KindObjectEnd, tok.Kind)
+
+	dec = NewDecoder(strings.NewReader(`[{"id": 1}, 2, {"id": 3} 4]`))
+	tok, err = dec.Token()
+	require.NoError(t, err)
+	assert.Equal(t, KindArrayStart, tok.Kind)
+	u := &testSliceUser{}
+	require.NoError(t, dec.Object(u))
+	tok, err = dec.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "2", tok.Value, "the comma after a value decoded by another method is checked")
+	require.NoError(t, dec.Object(u))
+	_, err = dec.Token()
+	var decErr *DecodeError
+	require.ErrorAs(t, err, &decErr)
+	assert.Equal(t, "',' or ']'", decErr.Expected)
+}
+
+func TestDecoderTokenErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		json     string
+		offset   int
+		path     string
+		expected string
+		found    string
+	}{
+		{
+			name:     "missing colon",
+			json:     `{"a" 1}`,
+			offset:   5,
+			path:     "$.a",
+			expected: "':'",
+			found:    "'1'",
+		},
+		{
+			name:     "key not a string",
+			json:     `{"a": 1, 2: 3}`,
+			offset:   9,
+			path:     "$.a",
+			expected: "string key or '}'",
+			found:    "'2'",
+		},
+		{
+			name:     "mismatched end",
+			json:     `{"a": [1, 2}`,
+			offset:   11,
+			path:     "$.a[1]",
+			expected: "',' or ']'",
+			found:    "'}'",
+		},
+		{
+			name:     "truncated",
+			json:     `[1, [2`,
+			offset:   6,
+			path:     "$[1][0]",
+			expected: "',' or ']'",
+			found:    "EOF",
+		},
+		{
+			name:     "missing comma in array",
+			json:     `[1 2,,3]`,
+			offset:   3,
+			path:     "$[0]",
+			expected: "',' or ']'",
+			found:    "'2'",
+		},
+		{
+			name:     "doubled comma in array",
+			json:     `[1, 2,,3]`,
+			offset:   6,
+			path:     "$[1]",
+			expected: "value",
+			found:    "','",
+		},
+		{
+			name:     "missing comma in object",
+			json:     `{"a":1 "b":2}`,
+			offset:   7,
+			path:     "$.a",
+			expected: "',' or '}'",
+			found:    "'\"'",
+		},
+		{
+			name:     "doubled comma in object",
+			json:     `{"a":1,,"b":2}`,
+			offset:   7,
+			path:     "$.a",
+			expected: "string key",
+			found:    "','",
+		},
+		{
+			name:     "leading comma in array",
+			json:     `[,1]`,
+			offset:   1,
+			path:     "$",
+			expected: "value or ']'",
+			found:    "','",
+		},
+		{
+			name:     "leading comma in object",
+			json:     `{,"a":1}`,
+			offset:   1,
+			path:     "$",
+			expected: "string key or '}'",
+			found:    "','",
+		},
+		{
+			name:     "trailing comma in array",
+			json:     `[1,]`,
+			offset:   3,
+			path:     "$[0]",
+			expected: "value",
+			found:    "']'",
+		},
+		{
+			name:     "trailing comma in object",
+			json:     `{"a":1,}`,
+			offset:   7,
+			path:     "$.a",
+			expected: "string key",
+			found:    "'}'",
+		},
+		{
+			name:     "comma before value",
+			json:     `{"a":,1}`,
+			offset:   5,
+			path:     "$.a",
+			expected: "value",
+			found:    "','",
+		},
+		{
+			name:     "comma at the top level",
+			json:     `1, 2`,
+			offset:   1,
+			path:     "$",
+			expected: "value",
+			found:    "','",
+		},
+		{
+			name:     "invalid literal",
+			json:     `[tru]`,
+			offset:   4,
+			path:     "$[0]",
+			expected: "true",
+			found:    "']'",
+		},
+		{
+			name:     "end at the top level",
+			json:     `1 ]`,
+			offset:   2,
+			path:     "$",
+			expected: "value",
+			found:    "']'",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			dec := NewDecoder(strings.NewReader(testCase.json))
+			var err error
+			for err == nil {
+				_, err = dec.Token()
+			}
+			var decErr *DecodeError
+			require.ErrorAs(t, err, &decErr)
+			assert.IsType(t, InvalidJSONError(""), decErr.Err)
+			assert.Equal(t, testCase.offset, decErr.Offset)
+			assert.Equal(t, testCase.path, decErr.Path)
+			assert.Equal(t, testCase.expected, decErr.Expected)
+			assert.Equal(t, testCase.found, decErr.Found)
+			_, err2 := dec.Token()
+			assert.Equal(t, err, err2, "the error is returned again")
+		})
+	}
+}
+
+func TestDecoderTokenMaxDepth(t *testing.T) {
+	t.Parallel()
+
+	dec := NewDecoder(strings.NewReader(`[[[1]]]`), WithMaxDepth(2))
+	var err error
+	for err == nil {
+		_, err = dec.Token()
+	}
+	var depthErr MaxDepthError
+	assert.ErrorAs(t, err, &depthErr)
+}
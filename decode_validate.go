@@ -0,0 +1,269 @@
+package gojay
+
+import (
+	"io"
+	"strings"
+	"sync"
+	"unicode/utf8"
+	"unsafe"
+)
+
+// validateBufPool holds the buffers of the decoders validating readers,
+// a buffer keeps the capacity it grew to when validating a large value.
+var validateBufPool = sync.Pool{
+	New: func() any {
+		buf := make([]byte, 512)
+		return &buf
+	},
+}
+
+// Valid reports whether data is a single JSON value as defined by RFC 8259,
+// optionally surrounded by whitespace. It does not decode nor modify data
+// and does not allocate for valid data, invalid data building the error which is not returned.
+func Valid(data []byte) bool {
+	dec := borrowDecoder(nil, 0)
+	defer dec.Release()
+	dec.data = data
+	dec.length = len(data)
+	return dec.validate() == nil
+}
+
+// Validate reads r until EOF and checks its content is a single JSON value as defined by RFC 8259,
+// optionally surrounded by whitespace.
+//
+// The input is validated while it is read, the data validated is dropped from the buffer as it goes,
+// which only has to hold the longest string or number of the input.
+// On invalid input the error returned is a *DecodeError locating the first error.
+// Options like WithMaxBytes or WithMaxDepth bound the input accepted.
+func Validate(r io.Reader, opts ...DecoderOption) error {
+	dec := borrowDecoder(r, 0, opts...)
+	defer dec.Release()
+	//nolint:forcetypeassert
+	buf := validateBufPool.Get().(*[]byte)
+	dec.data = *buf
+	if dec.maxBufferSize > 0 && len(dec.data) > dec.maxBufferSize {
+		dec.data = dec.data[:dec.maxBufferSize]
+	}
+	err := dec.validate()
+	// the buffer may have grown
+	*buf = dec.data[:cap(dec.data)]
+	// the released decoder must not keep the pooled buffer
+	dec.data = nil
+	validateBufPool.Put(buf)
+	return err
+}
+
+// validate checks the input is a single JSON value followed by whitespace only.
+func (dec *Decoder) validate() error {
+	if err := dec.validateValue(); err != nil {
+		return err
+	}
+	if dec.skipSpaces() != 0 {
+		return dec.raiseUnexpectedErr(dec.cursor, "end of input")
+	}
+	// reading stops on a reader error as well as on EOF
+	if dec.readErr != nil {
+		return dec.err
+	}
+	return nil
+}
+
+// validateValue checks the value starting at the next non whitespace char,
+// the cursor is placed right after the value.
+//
+//nolint:cyclop
+func (dec *Decoder) validateValue() error {
+	switch dec.skipSpaces() {
+	case '{':
+		dec.depth++
+		if err := dec.checkDepth(dec.depth, dec.cursor); err != nil {
+			return err
+		}
+		dec.cursor++
+		return dec.validateObject()
+	case '[':
+		dec.depth++
+		if err := dec.checkDepth(dec.depth, dec.cursor); err != nil {
+			return err
+		}
+		dec.cursor++
+		return dec.validateArray()
+	case '"':
+		dec.cursor++
+		return dec.validateString()
+	case 't':
+		return dec.validateLiteral("true")
+	case 'f':
+		return dec.validateLiteral("false")
+	case 'n':
+		return dec.validateLiteral("null")
+	case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		_, _, err := dec.getNumber()
+		return err
+	default:
+		return dec.raiseUnexpectedErr(dec.cursor, "value")
+	}
+}
+
+func (dec *Decoder) validateObject() error {
+	if dec.skipSpaces() == '}' {
+		dec.cursor++
+		dec.depth--
+		return nil
+	}
+	depth := len(dec.path)
+	for {
+		dec.discardValidated()
+		if dec.skipSpaces() != '"' {
+			return dec.raiseUnexpectedErr(dec.cursor, "string key")
+		}
+		dec.cursor++
+		start := dec.cursor
+		if err := dec.validateString(); err != nil {
+			return err
+		}
+		d := dec.data[start : dec.cursor-1]
+		dec.setPath(depth, pathElem{key: *(*string)(unsafe.Pointer(&d)), index: -1})
+		if dec.skipSpaces() != ':' {
+			return dec.raiseUnexpectedErr(dec.cursor, "':'")
+		}
+		dec.cursor++
+		if err := dec.validateValue(); err != nil {
+			return err
+		}
+		switch dec.skipSpaces() {
+		case ',':
+			dec.cursor++
+		case '}':
+			dec.cursor++
+			dec.path = dec.path[:depth]
+			dec.depth--
+			return nil
+		default:
+			return dec.raiseUnexpectedErr(dec.cursor, "',' or '}'")
+		}
+	}
+}
+
+func (dec *Decoder) validateArray() error {
+	if dec.skipSpaces() == ']' {
+		dec.cursor++
+		dec.depth--
+		return nil
+	}
+	depth := len(dec.path)
+	for i := 0; ; i++ {
+		dec.discardValidated()
+		dec.setPath(depth, pathElem{index: i})
+		if err := dec.validateValue(); err != nil {
+			return err
+		}
+		switch dec.skipSpaces() {
+		case ',':
+			dec.cursor++
+		case ']':
+			dec.cursor++
+			dec.path = dec.path[:depth]
+			dec.depth--
+			return nil
+		default:
+			return dec.raiseUnexpectedErr(dec.cursor, "',' or ']'")
+		}
+	}
+}
+
+// discardValidated moves the data not validated yet to the start of the buffer of a Decoder reading from an io.Reader,
+// once the data validated fills half of it, so that the buffer is reused whatever the size of the input.
+// The keys of the path point to the buffer, they are copied first.
+func (dec *Decoder) discardValidated() {
+	if dec.r == nil || dec.cursor == 0 || dec.cursor < len(dec.data)/2 {
+		return
+	}
+	for i, e := range dec.path {
+		if e.index < 0 {
+			dec.path[i] = pathElem{key: strings.Clone(e.name(dec.data)), index: -1}
+		}
+	}
+	buf := dec.data
+	dec.discard(dec.cursor)
+	dec.length = copy(buf, dec.data[:dec.length])
+	dec.data = buf
+}
+
+// validateLiteral checks the input at the cursor is lit, the cursor is placed right after it.
+func (dec *Decoder) validateLiteral(lit string) error {
+	for i := range len(lit) {
+		if (dec.cursor >= dec.length && !dec.read()) || dec.data[dec.cursor] != lit[i] {
+			return dec.raiseUnexpectedErr(dec.cursor, lit)
+		}
+		dec.cursor++
+	}
+	return nil
+}
+
+// validateString checks the string starting right after its opening quote,
+// its escape sequences and its UTF-8 encoding, without modifying it.
+// The cursor is placed right after the closing quote.
+func (dec *Decoder) validateString() error {
+	dec.tokenStart = dec.cursor
+	for dec.cursor < dec.length || dec.readToken() {
+		switch c := dec.data[dec.cursor]; {
+		case c == '"':
+			if err := dec.checkTokenLength(dec.cursor); err != nil {
+				return err
+			}
+			dec.cursor++
+			return nil
+		case c == '\\':
+			dec.cursor++
+			if err := dec.validateEscape(); err != nil {
+				return err
+			}
+		case c < 0x20:
+			return dec.raiseUnexpectedErr(dec.cursor, "escaped control character")
+		case c < utf8.RuneSelf:
+			dec.cursor++
+		default:
+			// a multi-byte character may be split between two reads
+			for dec.length-dec.cursor < utf8.UTFMax {
+				if !dec.readToken() {
+					break
+				}
+			}
+			r, size := utf8.DecodeRune(dec.data[dec.cursor:dec.length])
+			if r == utf8.RuneError && size == 1 {
+				return dec.raiseInvalidUTF8Err(dec.cursor, invalidUTF8ErrorMsg, "UTF-8 character")
+			}
+			dec.cursor += size
+		}
+	}
+	return dec.raiseUnexpectedErr(dec.cursor, "'\"'")
+}
+
+// validateEscape checks the escape sequence following a backslash,
+// the cursor is placed right after it.
+func (dec *Decoder) validateEscape() error {
+	if dec.cursor >= dec.length && !dec.readToken() {
+		return dec.raiseUnexpectedErr(dec.cursor, "escape sequence")
+	}
+	switch dec.data[dec.cursor] {
+	case '"', '\\', '/', 'b', 'f', 'n', 'r', 't':
+		dec.cursor++
+		return nil
+	case 'u':
+		dec.cursor++
+		for range 4 {
+			if (dec.cursor >= dec.length && !dec.readToken()) || !isHexDigit(dec.data[dec.cursor]) {
+				return dec.raiseUnexpectedErr(dec.cursor, "hexadecimal digit")
+			}
+			dec.cursor++
+		}
+		return nil
+	default:
+		return dec.raiseUnexpectedErr(dec.cursor, "escape sequence")
+	}
+}
+
+func isHexDigit(b byte) bool {
+	return isDigit(b) || (b >= 'a' && b <= 'f') || (b >= 'A' && b <= 'F')
+}
@@ -0,0 +1,146 @@
+package gojay
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name  string
+		json  string
+		valid bool
+		// encoding/json accepts invalid UTF-8 while RFC 8259 requires UTF-8
+		invalidUTF8 bool
+	}{
+		{name: "object", json: `{"a":[1,-2.5e+3,"x\u00e9\n",true,false,null,{}],"b":{"c":[]}}`, valid: true},
+		{name: "scalar", json: ` "été" `, valid: true},
+		{name: "number", json: "0", valid: true},
+		{name: "whitespace", json: "\t\r\n[ 1 , 2 ]\n", valid: true},
+		{name: "surrogate-escape", json: `"\ud83d\ude00\ud800"`, valid: true},
+		{name: "empty", json: ""},
+		{name: "spaces-only", json: "  "},
+		{name: "trailing-data", json: `{} {}`},
+		{name: "trailing-comma-object", json: `{"a":1,}`},
+		{name: "trailing-comma-array", json: `[1,]`},
+		{name: "missing-colon", json: `{"a" 1}`},
+		{name: "non-string-key", json: `{1:1}`},
+		{name: "unclosed-object", json: `{"a":1`},
+		{name: "unclosed-array", json: `[1`},
+		{name: "unclosed-string", json: `"abc`},
+		{name: "leading-zero", json: `01`},
+		{name: "dot-without-fraction", json: `1.`},
+		{name: "minus-alone", json: `-`},
+		{name: "exponent-without-digits", json: `1e+`},
+		{name: "plus-sign", json: `+1`},
+		{name: "truncated-literal", json: `tru`},
+		{name: "literal-suffix", json: `truex`},
+		{name: "literal-case", json: `Null`},
+		{name: "invalid-escape", json: `"\x"`},
+		{name: "short-unicode-escape", json: `"\u12"`},
+		{name: "raw-control-char", json: "\"a\tb\""},
+		{name: "invalid-utf8", json: "\"a\xffb\"", invalidUTF8: true},
+		{name: "truncated-utf8", json: "\"\xc3\"", invalidUTF8: true},
+		{name: "single-quotes", json: `'a'`},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			data := []byte(testCase.json)
+			assert.Equal(t, testCase.valid, Valid(data))
+			if !testCase.invalidUTF8 {
+				assert.Equal(t, json.Valid(data), Valid(data), "same result as encoding/json")
+			}
+			assert.Equal(t, testCase.json, string(data), "data must not be modified")
+			err := Validate(iotest.OneByteReader(strings.NewReader(testCase.json)))
+			if testCase.valid {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorAs(t, err, new(*DecodeError))
+			}
+		})
+	}
+}
+
+func TestValidateErrorPosition(t *testing.T) {
+	t.Parallel()
+
+	err := Validate(strings.NewReader("{\n  \"a\": [1, 2],\n  \"b\": {\"c\": \"\\q\"}\n}"))
+	var decErr *DecodeError
+	require.ErrorAs(t, err, &decErr)
+	assert.Equal(t, 3, decErr.Line)
+	assert.Equal(t, 16, decErr.Column)
+	assert.Equal(t, "$.b.c", decErr.Path)
+	assert.Equal(t, "escape sequence", decErr.Expected)
+	assert.Equal(t, "'q'", decErr.Found)
+}
+
+func TestValidateEOFError(t *testing.T) {
+	t.Parallel()
+
+	// the pooled buffer holds the data of a previous input which must not be reported
+	require.NoError(t, Validate(strings.NewReader(`{"a":     1}`)))
+	for _, input := range []string{"", "1.", `{"a":`} {
+		err := Validate(strings.NewReader(input))
+		var decErr *DecodeError
+		require.ErrorAs(t, err, &decErr)
+		assert.Equal(t, "EOF", decErr.Found)
+		assert.Equal(t, fmt.Sprintf(invalidJSONCharErrorMsg, 0, len(input)), decErr.Err.Error(), input)
+	}
+}
+
+func TestValidateOptions(t *testing.T) {
+	t.Parallel()
+
+	err := Validate(strings.NewReader(`[[[1]]]`), WithMaxDepth(2))
+	require.ErrorAs(t, err, new(MaxDepthError))
+	err = Validate(strings.NewReader(`["abcdef"]`), WithMaxBytes(5))
+	require.ErrorAs(t, err, new(MaxBytesError))
+
+	readErr := errors.New("read failed")
+	err = Validate(iotest.TimeoutReader(strings.NewReader(`["a", "b"]`)))
+	require.ErrorIs(t, err, iotest.ErrTimeout)
+	err = Validate(iotest.ErrReader(readErr))
+	require.ErrorIs(t, err, readErr)
+}
+
+func TestValidateLargeInput(t *testing.T) {
+	t.Parallel()
+
+	const n = 10000
+	item := `{"id": 1, "name": "` + strings.Repeat("a", 20) + `", "tags": ["x", "y"]}`
+	input := `{"items": [` + strings.Repeat(item+", ", n) + item + `]}`
+	require.Greater(t, len(input), 100*1024)
+	require.NoError(t, Validate(strings.NewReader(input), WithMaxBufferSize(1024)))
+	require.NoError(t, Validate(iotest.OneByteReader(strings.NewReader(input)), WithMaxBufferSize(1024)))
+
+	// errors are still located once the start of the input is dropped
+	input = strings.Replace(input, `"tags": ["x", "y"]}]}`, `"tags": ["x", y]}]}`, 1)
+	err := Validate(strings.NewReader(input), WithMaxBufferSize(1024))
+	var decErr *DecodeError
+	require.ErrorAs(t, err, &decErr)
+	assert.Equal(t, strings.LastIndexByte(input, 'y'), decErr.Offset)
+	assert.Equal(t, "$.items[10000].tags[1]", decErr.Path)
+}
+
+func TestValidNoAlloc(t *testing.T) {
+	if raceEnabled {
+		t.Skip("the race detector allocates")
+	}
+	data := []byte(`{"a":[1,-2.5e+3,"x\u00e9\n",true,false,null,{}],"b":{"c":[{"d":"e"}]}}`)
+	Valid(data)
+	allocs := testing.AllocsPerRun(100, func() {
+		Valid(data)
+	})
+	assert.Zero(t, allocs)
+}
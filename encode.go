@@ -9,6 +9,7 @@ import (
 var nullBytes = []byte("null")
 
 // MarshalJSONArray returns the JSON encoding of v, an implementation of MarshalerJSONArray.
+// On error, no bytes are returned.
 //
 // Example:
 //
@@ -32,7 +33,7 @@ func MarshalJSONArray(v MarshalerJSONArray) ([]byte, error) {
 	enc := BorrowEncoder(nil)
 	enc.grow(512)
 	enc.writeByte('[')
-	v.MarshalJSONArray(enc)
+	enc.marshalArray(v)
 	enc.writeByte(']')
 
 	defer func() {
@@ -40,10 +41,14 @@ func MarshalJSONArray(v MarshalerJSONArray) ([]byte, error) {
 		enc.Release()
 	}()
 
+	if enc.err != nil {
+		return nil, enc.err
+	}
 	return enc.buf, nil
 }
 
 // MarshalJSONObject returns the JSON encoding of v, an implementation of MarshalerJSONObject.
+// On error, no bytes are returned.
 //
 // Example:
 //
@@ -73,7 +78,11 @@ func MarshalJSONObject(v MarshalerJSONObject) ([]byte, error) {
 		enc.Release()
 	}()
 
-	return enc.encodeObject(v)
+	b, err := enc.encodeObject(v)
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
 }
 
 // Marshal returns the JSON encoding of v.
@@ -83,6 +92,7 @@ func MarshalJSONObject(v MarshalerJSONObject) ([]byte, error) {
 //	string, int, int8, int16, int32, int64, uint8, uint16, uint32, uint64, float64, float32, bool
 //
 // Marshal returns an InvalidMarshalError.
+// On error, no bytes are returned.
 func Marshal(v any) ([]byte, error) {
 	return marshal(v, false)
 }
@@ -98,10 +108,22 @@ func MarshalAny(v any) ([]byte, error) {
 	return marshal(v, true)
 }
 
+// MarshalIndent is like Marshal but applies WithIndent(prefix, indent) to format the output,
+// each JSON element of an object or an array beginning on a new indented line like with json.MarshalIndent.
+func MarshalIndent(v any, prefix, indent string) ([]byte, error) {
+	return marshal(v, false, WithIndent(prefix, indent))
+}
+
+// MarshalHTMLSafe is like Marshal but applies WithHTMLSafe to escape <, >, &, U+2028 and U+2029 in strings,
+// so that the JSON can be embedded in an HTML <script> tag or in JavaScript.
+func MarshalHTMLSafe(v any) ([]byte, error) {
+	return marshal(v, false, WithHTMLSafe())
+}
+
 //nolint:cyclop
-func marshal(v any, b bool) ([]byte, error) {
+func marshal(v any, b bool, opts ...EncoderOption) ([]byte, error) {
 	var (
-		enc = BorrowEncoder(nil)
+		enc = BorrowEncoder(nil, opts...)
 
 		buf []byte
 		err error
@@ -144,6 +166,16 @@ func marshal(v any, b bool) ([]byte, error) {
 			return enc.encodeFloat(vt)
 		case float32:
 			return enc.encodeFloat32(vt)
+		case Number:
+			enc.appendNumber(vt)
+			return enc.buf, enc.err
+		case json.Number:
+			enc.appendNumber(Number(vt))
+			return enc.buf, enc.err
+		case map[string]any:
+			return enc.encodeObject(interfaceObject(vt))
+		case []any:
+			return enc.encodeArray(interfaceArray(vt))
 		case *EmbeddedJSON:
 			return enc.encodeEmbeddedJSON(vt)
 		default:
@@ -154,6 +186,14 @@ func marshal(v any, b bool) ([]byte, error) {
 			return nil, InvalidMarshalError(fmt.Sprintf(invalidMarshalErrorMsg, vt))
 		}
 	}()
+	if err != nil {
+		// what was encoded before the error is not valid JSON
+		return nil, err
+	}
+	if enc.indented {
+		// the buffer is returned, the indented JSON must not be in the one of the Encoder
+		buf = appendIndent(make([]byte, 0, len(buf)*2), buf, enc.prefix, enc.indent)
+	}
 	return buf, err
 }
 
@@ -178,6 +218,14 @@ type Encoder struct {
 	err      error
 	hasKeys  bool
 	keys     []string
+	// indented is set by WithIndent, the buffer is indented to indentBuf when it is written
+	indented  bool
+	prefix    string
+	indent    string
+	indentBuf []byte
+	// htmlSafe is set by WithHTMLSafe and utf8Policy by WithEncoderUTF8Policy
+	htmlSafe   bool
+	utf8Policy EncoderUTF8Policy
 }
 
 // AppendBytes allows a modular usage by appending bytes manually to the current state of the buffer.
@@ -196,12 +244,51 @@ func (enc *Encoder) Buf() []byte {
 }
 
 // Write writes to the io.Writer and resets the buffer.
+// The buffer is indented as it is written if the Encoder has the WithIndent option.
 func (enc *Encoder) Write() (int, error) {
-	i, err := enc.w.Write(enc.buf)
+	buf := enc.buf
+	if enc.indented {
+		enc.indentBuf = appendIndent(enc.indentBuf[:0], enc.buf, enc.prefix, enc.indent)
+		buf = enc.indentBuf
+	}
+	i, err := enc.w.Write(buf)
 	enc.buf = enc.buf[:0]
 	return i, err
 }
 
+// SetError aborts the encoding with err, it is meant to be called by a MarshalJSONObject or MarshalJSONArray method
+// which cannot encode its value, a required field being nil for example.
+// Only the first error is kept. Once it is set, the marshalers of the next objects and arrays are not called anymore
+// and the error is returned by Marshal, EncodeObject, EncodeArray or the other Encode methods,
+// whatever the nesting of the object or array setting it. Nothing is written to the io.Writer.
+//
+// The Encoder stays failed after its first error: the next calls to EncodeObject or the other Encode methods
+// return it again without encoding anything. A new Encoder, or one borrowed again after Release, starts without error.
+func (enc *Encoder) SetError(err error) {
+	if enc.err == nil {
+		enc.err = err
+	}
+}
+
+// Err returns the error of the Encoder, set by SetError or by a value which cannot be encoded.
+func (enc *Encoder) Err() error {
+	return enc.err
+}
+
+// marshalObject calls the MarshalJSONObject method of v unless the encoding was aborted.
+func (enc *Encoder) marshalObject(v MarshalerJSONObject) {
+	if enc.err == nil {
+		v.MarshalJSONObject(enc)
+	}
+}
+
+// marshalArray calls the MarshalJSONArray method of v unless the encoding was aborted.
+func (enc *Encoder) marshalArray(v MarshalerJSONArray) {
+	if enc.err == nil {
+		v.MarshalJSONArray(enc)
+	}
+}
+
 func (enc *Encoder) getPreviousRune() byte {
 	last := len(enc.buf) - 1
 	return enc.buf[last]
@@ -5,8 +5,12 @@ func (enc *Encoder) EncodeArray(v MarshalerJSONArray) error {
 	if enc.isPooled == 1 {
 		panic(InvalidUsagePooledEncoderError("Invalid usage of pooled encoder"))
 	}
-	_, _ = enc.encodeArray(v)
-	_, err := enc.Write()
+	_, err := enc.encodeArray(v)
+	if err != nil {
+		enc.err = err
+		return err
+	}
+	_, err = enc.Write()
 	if err != nil {
 		enc.err = err
 		return err
@@ -17,7 +21,7 @@ func (enc *Encoder) EncodeArray(v MarshalerJSONArray) error {
 func (enc *Encoder) encodeArray(v MarshalerJSONArray) ([]byte, error) {
 	enc.grow(200)
 	enc.writeByte('[')
-	v.MarshalJSONArray(enc)
+	enc.marshalArray(v)
 	enc.writeByte(']')
 	return enc.buf, enc.err
 }
@@ -77,7 +81,7 @@ func (enc *Encoder) Array(v MarshalerJSONArray) {
 		enc.writeByte(',')
 	}
 	enc.writeByte('[')
-	v.MarshalJSONArray(enc)
+	enc.marshalArray(v)
 	enc.writeByte(']')
 }
 
@@ -93,7 +97,7 @@ func (enc *Encoder) ArrayOmitEmpty(v MarshalerJSONArray) {
 		enc.writeByte(',')
 	}
 	enc.writeByte('[')
-	v.MarshalJSONArray(enc)
+	enc.marshalArray(v)
 	enc.writeByte(']')
 }
 
@@ -110,7 +114,7 @@ func (enc *Encoder) ArrayNullEmpty(v MarshalerJSONArray) {
 		return
 	}
 	enc.writeByte('[')
-	v.MarshalJSONArray(enc)
+	enc.marshalArray(v)
 	enc.writeByte(']')
 }
 
@@ -142,7 +146,7 @@ func (enc *Encoder) ArrayKey(key string, v MarshalerJSONArray) {
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
 	enc.writeBytes(objKeyArr)
-	v.MarshalJSONArray(enc)
+	enc.marshalArray(v)
 	enc.writeByte(']')
 }
 
@@ -165,7 +169,7 @@ func (enc *Encoder) ArrayKeyOmitEmpty(key string, v MarshalerJSONArray) {
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
 	enc.writeBytes(objKeyArr)
-	v.MarshalJSONArray(enc)
+	enc.marshalArray(v)
 	enc.writeByte(']')
 }
 
@@ -189,7 +193,7 @@ func (enc *Encoder) ArrayKeyNullEmpty(key string, v MarshalerJSONArray) {
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
 	enc.writeBytes(objKeyArr)
-	v.MarshalJSONArray(enc)
+	enc.marshalArray(v)
 	enc.writeByte(']')
 }
 
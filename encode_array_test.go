@@ -108,7 +108,6 @@ func TestEncoderArrayMarshalAPI(t *testing.T) {
 			1,
 			int64(1),
 			int32(1),
-			int16(1),
 			int8(1),
 			uint64(1),
 			uint32(1),
@@ -135,6 +134,9 @@ func TestEncoderArrayMarshalAPI(t *testing.T) {
 			`[1,1,1,1,1,1,1,1,1.31,1.31,[],[],true,false,"test",{"test":"hello world","test2":"foobar","testInt":1,"testBool":true,"testArr":[],"testF64":0,"testF32":0,"sub":{}}]`,
 			string(r),
 			"Result of marshalling is different as the one expected")
+
+		_, err = MarshalJSONArray(&testEncodingArrInterfaces{1, int16(1), &TestEncodingArr{}})
+		assert.IsType(t, InvalidMarshalError(""), err)
 	})
 }
 
@@ -148,7 +150,6 @@ func TestEncoderArrayEncodeAPI(t *testing.T) {
 			1,
 			int64(1),
 			int32(1),
-			int16(1),
 			int8(1),
 			uint64(1),
 			uint32(1),
@@ -176,6 +177,13 @@ func TestEncoderArrayEncodeAPI(t *testing.T) {
 			`[1,1,1,1,1,1,1,1,1.31,[],true,"test",{"test":"hello world","test2":"foobar","testInt":1,"testBool":true,"testArr":[],"testF64":0,"testF32":0,"sub":{}}]`,
 			builder.String(),
 			"Result of marshalling is different as the one expected")
+
+		builder.Reset()
+		enc = BorrowEncoder(builder)
+		defer enc.Release()
+		err = enc.EncodeArray(&testEncodingArrInterfaces{1, int16(1), &TestEncodingArr{}})
+		assert.IsType(t, InvalidMarshalError(""), err)
+		assert.Empty(t, builder.String())
 	})
 
 	t.Run("array-interfaces-write-error", func(t *testing.T) {
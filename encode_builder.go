@@ -1,5 +1,10 @@
 package gojay
 
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
 const hex = "0123456789abcdef"
 
 // grow grows b's capacity, if necessary, to guarantee space for
@@ -36,29 +41,140 @@ func (enc *Encoder) writeString(s string) {
 }
 
 func (enc *Encoder) writeStringEscape(s string) {
+	if enc.htmlSafe || enc.utf8Policy != EncodeUTF8Unchecked {
+		enc.writeStringEscapeChecked(s)
+		return
+	}
 	for i := range len(s) {
 		c := s[i]
 		if c >= 0x20 && c != '\\' && c != '"' {
 			enc.writeByte(c)
 			continue
 		}
-		switch c {
-		case '\\', '"':
-			enc.writeTwoBytes('\\', c)
-		case '\n':
-			enc.writeTwoBytes('\\', 'n')
-		case '\f':
-			enc.writeTwoBytes('\\', 'f')
-		case '\b':
-			enc.writeTwoBytes('\\', 'b')
-		case '\r':
-			enc.writeTwoBytes('\\', 'r')
-		case '\t':
-			enc.writeTwoBytes('\\', 't')
+		enc.writeByteEscape(c)
+	}
+}
+
+// writeByteEscape writes the escape sequence of a quote, a backslash or a control character.
+func (enc *Encoder) writeByteEscape(c byte) {
+	switch c {
+	case '\\', '"':
+		enc.writeTwoBytes('\\', c)
+	case '\n':
+		enc.writeTwoBytes('\\', 'n')
+	case '\f':
+		enc.writeTwoBytes('\\', 'f')
+	case '\b':
+		enc.writeTwoBytes('\\', 'b')
+	case '\r':
+		enc.writeTwoBytes('\\', 'r')
+	case '\t':
+		enc.writeTwoBytes('\\', 't')
+	default:
+		enc.writeString(`\u00`)
+		enc.writeTwoBytes(hex[c>>4], hex[c&0xF])
+	}
+}
+
+// safeASCII tells the ASCII chars written as they are in strings,
+// htmlSafeASCII the ones written as they are with the WithHTMLSafe option.
+var safeASCII, htmlSafeASCII = func() (safe, htmlSafe [utf8.RuneSelf]bool) {
+	for c := byte(0x20); c < utf8.RuneSelf; c++ {
+		safe[c] = c != '\\' && c != '"'
+		htmlSafe[c] = safe[c] && c != '<' && c != '>' && c != '&'
+	}
+	return safe, htmlSafe
+}()
+
+// writeStringEscapeChecked is writeStringEscape for an Encoder with the WithHTMLSafe or WithEncoderUTF8Policy options.
+// With WithHTMLSafe, <, > and & are escaped as well as the line and paragraph separators U+2028 and U+2029,
+// invalid UTF-8 is handled according to the EncoderUTF8Policy of the Encoder.
+// Runs of ASCII chars which need no escaping are written at once.
+func (enc *Encoder) writeStringEscapeChecked(s string) {
+	safe := &safeASCII
+	if enc.htmlSafe {
+		safe = &htmlSafeASCII
+	}
+	start := 0
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c < utf8.RuneSelf {
+			if safe[c] {
+				i++
+				continue
+			}
+			enc.writeString(s[start:i])
+			if c == '<' || c == '>' || c == '&' {
+				enc.writeString(`\u00`)
+				enc.writeTwoBytes(hex[c>>4], hex[c&0xF])
+			} else {
+				enc.writeByteEscape(c)
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case r == utf8.RuneError && size == 1 && enc.utf8Policy != EncodeUTF8Unchecked:
+			enc.writeString(s[start:i])
+			enc.writeInvalidUTF8(s, i)
+		case enc.htmlSafe && (r == '\u2028' || r == '\u2029'):
+			enc.writeString(s[start:i])
+			enc.writeString(`\u202`)
+			enc.writeByte(hex[r&0xF])
 		default:
+			i += size
+			continue
+		}
+		i += size
+		start = i
+	}
+	enc.writeString(s[start:])
+}
+
+// writeInvalidUTF8 handles the invalid byte of s at i according to the EncoderUTF8Policy of the Encoder.
+// With EncodeUTF8Reject it sets an InvalidUTF8Error on the Encoder, the byte being replaced with U+FFFD.
+func (enc *Encoder) writeInvalidUTF8(s string, i int) {
+	switch enc.utf8Policy {
+	case EncodeUTF8Escape:
+		enc.writeString(`\u00`)
+		enc.writeTwoBytes(hex[s[i]>>4], hex[s[i]&0xF])
+	case EncodeUTF8Reject:
+		if enc.err == nil {
+			enc.err = InvalidUTF8Error(fmt.Sprintf(invalidUTF8EncodeErrorMsg, s[i], i))
+		}
+		enc.writeString(string(utf8.RuneError))
+	default:
+		enc.writeString(string(utf8.RuneError))
+	}
+}
+
+// isLineSeparator reports whether s has U+2028 or U+2029 at i, encoded as E2 80 A8 or E2 80 A9.
+func isLineSeparator(s []byte, i int) bool {
+	return s[i] == 0xE2 && i+2 < len(s) && s[i+1] == 0x80 && s[i+2]&^1 == 0xA8
+}
+
+// writeEmbeddedJSON writes the JSON of an EmbeddedJSON,
+// <, >, & and U+2028 and U+2029 being escaped if the Encoder has the WithHTMLSafe option.
+// They can only be found in strings in valid JSON, where they can be escaped.
+func (enc *Encoder) writeEmbeddedJSON(v []byte) {
+	if !enc.htmlSafe {
+		enc.writeBytes(v)
+		return
+	}
+	for i := 0; i < len(v); i++ {
+		c := v[i]
+		switch {
+		case c == '<' || c == '>' || c == '&':
 			enc.writeString(`\u00`)
 			enc.writeTwoBytes(hex[c>>4], hex[c&0xF])
+		case isLineSeparator(v, i):
+			enc.writeString(`\u202`)
+			enc.writeByte(hex[v[i+2]&0xF])
+			i += 2
+		default:
+			enc.writeByte(c)
 		}
-		continue
 	}
 }
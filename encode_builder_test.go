@@ -1 +1,128 @@
 package gojay
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testHTMLObject struct {
+	s string
+}
+
+func (o *testHTMLObject) MarshalJSONObject(enc *Encoder) {
+	enc.StringKey(o.s, o.s)
+	enc.ArrayKey("arr", EncodeSlice([]string{o.s}, (*Encoder).String))
+	enc.AddEmbeddedJSONKey("raw", &EmbeddedJSON{'"', '<', '/', '"'})
+}
+
+func (o *testHTMLObject) IsNil() bool {
+	return o == nil
+}
+
+func TestMarshalHTMLSafe(t *testing.T) {
+	t.Parallel()
+
+	const s = "</script><b>&\u2028\u2029\"\\\n\u2027é"
+	got, err := MarshalHTMLSafe(&testHTMLObject{s: s})
+	require.NoError(t, err)
+	const escaped = `\u003c/script\u003e\u003cb\u003e\u0026\u2028\u2029\"\\\n` + "\u2027é"
+	assert.Equal(t, `{"`+escaped+`":"`+escaped+`","arr":["`+escaped+`"],"raw":"\u003c/"}`, string(got))
+
+	expected, err := json.Marshal(map[string]any{s: s, "arr": []string{s}, "raw": json.RawMessage(`"</"`)})
+	require.NoError(t, err)
+	assert.JSONEq(t, string(expected), string(got))
+
+	got, err = MarshalHTMLSafe("<\xe2\x80")
+	require.NoError(t, err)
+	assert.Equal(t, "\"\\u003c\xe2\x80\"", string(got), "a truncated separator is not escaped")
+
+	got, err = Marshal(&testHTMLObject{s: "<&>"})
+	require.NoError(t, err)
+	assert.Equal(t, `{"<&>":"<&>","arr":["<&>"],"raw":"</"}`, string(got), "strings are not escaped by default")
+}
+
+func TestEncoderWithHTMLSafe(t *testing.T) {
+	t.Parallel()
+
+	builder := &strings.Builder{}
+	enc := BorrowEncoder(builder, WithHTMLSafe())
+	defer enc.Release()
+	enc.AppendString("<a>")
+	assert.Equal(t, `"\u003ca\u003e"`, string(enc.Buf()))
+	_, err := enc.Write()
+	require.NoError(t, err)
+
+	builder.Reset()
+	v := EmbeddedJSON(`{"a":"<&>"}`)
+	require.NoError(t, enc.EncodeEmbeddedJSON(&v))
+	assert.Equal(t, `{"a":"\u003c\u0026\u003e"}`, builder.String())
+	assert.Equal(t, `{"a":"<&>"}`, string(v), "the embedded JSON is not modified")
+
+	builder.Reset()
+	require.NoError(t, BorrowDecoder(strings.NewReader(`{"<": "\u2028"}`)).Reformat(builder, WithHTMLSafe()))
+	assert.Equal(t, `{"\u003c":"\u2028"}`, builder.String())
+}
+
+func TestEncoderWithUTF8Policy(t *testing.T) {
+	t.Parallel()
+
+	const s = "a\xffb\xe2\x28c<é "
+	testCases := []struct {
+		name     string
+		policy   EncoderUTF8Policy
+		expected string
+	}{
+		{name: "unchecked", policy: EncodeUTF8Unchecked, expected: `"` + s + `"`},
+		{name: "replace", policy: EncodeUTF8Replace, expected: "\"a�b�(c<é \""},
+		{name: "escape", policy: EncodeUTF8Escape, expected: "\"a\\u00ffb\\u00e2(c<é \""},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			builder := &strings.Builder{}
+			enc := NewEncoder(builder, WithEncoderUTF8Policy(testCase.policy))
+			require.NoError(t, enc.EncodeString(s))
+			assert.Equal(t, testCase.expected, builder.String())
+		})
+	}
+
+	t.Run("escape-decoded", func(t *testing.T) {
+		t.Parallel()
+
+		builder := &strings.Builder{}
+		enc := NewEncoder(builder, WithEncoderUTF8Policy(EncodeUTF8Escape))
+		require.NoError(t, enc.EncodeString("\xff"))
+		var v string
+		require.NoError(t, Unmarshal([]byte(builder.String()), &v))
+		assert.Equal(t, "\u00ff", v, "the escaped byte is decoded as U+00FF")
+	})
+
+	t.Run("html-safe", func(t *testing.T) {
+		t.Parallel()
+
+		builder := &strings.Builder{}
+		enc := NewEncoder(builder, WithEncoderUTF8Policy(EncodeUTF8Replace), WithHTMLSafe())
+		require.NoError(t, enc.EncodeString(s))
+		assert.Equal(t, "\"a�b�(c\\u003cé\\u2028\"", builder.String())
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		t.Parallel()
+
+		builder := &strings.Builder{}
+		enc := NewEncoder(builder, WithEncoderUTF8Policy(EncodeUTF8Reject))
+		err := enc.EncodeObject(&testHTMLObject{s: s})
+		assert.Equal(t, InvalidUTF8Error("Invalid UTF-8 encoding, byte 0xff at index 1 of a string"), err)
+		assert.Empty(t, builder.String(), "nothing is written")
+
+		enc = BorrowEncoder(nil, WithEncoderUTF8Policy(EncodeUTF8Reject))
+		defer enc.Release()
+		_, err = enc.encodeString("valid é")
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,140 @@
+package gojay
+
+import "encoding/base64"
+
+// EncodeBytes encodes a []byte to a JSON string with the given base64 encoding,
+// like base64.StdEncoding, base64.URLEncoding, base64.RawStdEncoding or base64.RawURLEncoding.
+// A nil encoding is base64.StdEncoding, the one used by encoding/json.
+func (enc *Encoder) EncodeBytes(v []byte, encoding *base64.Encoding) error {
+	if enc.isPooled == 1 {
+		panic(InvalidUsagePooledEncoderError("Invalid usage of pooled encoder"))
+	}
+	enc.appendBase64(v, encoding)
+	_, err := enc.Write()
+	if err != nil {
+		enc.err = err
+		return err
+	}
+	return nil
+}
+
+// AddBytes adds a []byte to be encoded as a base64 string with the given encoding,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) AddBytes(v []byte, encoding *base64.Encoding) {
+	enc.Bytes(v, encoding)
+}
+
+// AddBytesOmitEmpty adds a []byte to be encoded as a base64 string with the given encoding and skips it if it is empty,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) AddBytesOmitEmpty(v []byte, encoding *base64.Encoding) {
+	enc.BytesOmitEmpty(v, encoding)
+}
+
+// AddBytesNullEmpty adds a []byte to be encoded as a base64 string with the given encoding and encodes null if it is empty,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) AddBytesNullEmpty(v []byte, encoding *base64.Encoding) {
+	enc.BytesNullEmpty(v, encoding)
+}
+
+// Bytes adds a []byte to be encoded as a base64 string with the given encoding,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) Bytes(v []byte, encoding *base64.Encoding) {
+	enc.bytes(v, encoding, false, false)
+}
+
+// BytesOmitEmpty adds a []byte to be encoded as a base64 string with the given encoding and skips it if it is empty,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) BytesOmitEmpty(v []byte, encoding *base64.Encoding) {
+	enc.bytes(v, encoding, true, false)
+}
+
+// BytesNullEmpty adds a []byte to be encoded as a base64 string with the given encoding and encodes null if it is empty,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) BytesNullEmpty(v []byte, encoding *base64.Encoding) {
+	enc.bytes(v, encoding, false, true)
+}
+
+// AddBytesKey adds a []byte to be encoded as a base64 string with the given encoding,
+// must be used inside an object as it will encode a key.
+func (enc *Encoder) AddBytesKey(key string, v []byte, encoding *base64.Encoding) {
+	enc.BytesKey(key, v, encoding)
+}
+
+// AddBytesKeyOmitEmpty adds a []byte to be encoded as a base64 string with the given encoding and skips it if it is empty.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) AddBytesKeyOmitEmpty(key string, v []byte, encoding *base64.Encoding) {
+	enc.BytesKeyOmitEmpty(key, v, encoding)
+}
+
+// AddBytesKeyNullEmpty adds a []byte to be encoded as a base64 string with the given encoding and encodes null if it is empty.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) AddBytesKeyNullEmpty(key string, v []byte, encoding *base64.Encoding) {
+	enc.BytesKeyNullEmpty(key, v, encoding)
+}
+
+// BytesKey adds a []byte to be encoded as a base64 string with the given encoding,
+// must be used inside an object as it will encode a key.
+func (enc *Encoder) BytesKey(key string, v []byte, encoding *base64.Encoding) {
+	enc.bytesKey(key, v, encoding, false, false)
+}
+
+// BytesKeyOmitEmpty adds a []byte to be encoded as a base64 string with the given encoding and skips it if it is empty.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) BytesKeyOmitEmpty(key string, v []byte, encoding *base64.Encoding) {
+	enc.bytesKey(key, v, encoding, true, false)
+}
+
+// BytesKeyNullEmpty adds a []byte to be encoded as a base64 string with the given encoding and encodes null if it is empty.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) BytesKeyNullEmpty(key string, v []byte, encoding *base64.Encoding) {
+	enc.bytesKey(key, v, encoding, false, true)
+}
+
+func (enc *Encoder) bytes(v []byte, encoding *base64.Encoding, omitEmpty, nullEmpty bool) {
+	if omitEmpty && len(v) == 0 {
+		return
+	}
+	r := enc.getPreviousRune()
+	if r != '[' {
+		enc.writeByte(',')
+	}
+	if nullEmpty && len(v) == 0 {
+		enc.writeBytes(nullBytes)
+		return
+	}
+	enc.appendBase64(v, encoding)
+}
+
+func (enc *Encoder) bytesKey(key string, v []byte, encoding *base64.Encoding, omitEmpty, nullEmpty bool) {
+	if enc.hasKeys {
+		if !enc.keyExists(key) {
+			return
+		}
+	}
+	if omitEmpty && len(v) == 0 {
+		return
+	}
+	enc.grow(len(key) + 5)
+	r := enc.getPreviousRune()
+	if r != '{' {
+		enc.writeByte(',')
+	}
+	enc.writeByte('"')
+	enc.writeStringEscape(key)
+	enc.writeBytes(objKey)
+	if nullEmpty && len(v) == 0 {
+		enc.writeBytes(nullBytes)
+		return
+	}
+	enc.appendBase64(v, encoding)
+}
+
+// appendBase64 writes v as a base64 JSON string, base64 alphabets never need to be escaped.
+func (enc *Encoder) appendBase64(v []byte, encoding *base64.Encoding) {
+	if encoding == nil {
+		encoding = base64.StdEncoding
+	}
+	enc.writeByte('"')
+	enc.buf = encoding.AppendEncode(enc.buf, v)
+	enc.writeByte('"')
+}
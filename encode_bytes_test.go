@@ -0,0 +1,75 @@
+package gojay
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (b *testBytes) MarshalJSONObject(enc *Encoder) {
+	enc.BytesKey("std", b.std, nil)
+	enc.AddBytesKeyOmitEmpty("url", b.url, base64.URLEncoding)
+	enc.BytesKeyNullEmpty("raw", b.raw, base64.RawStdEncoding)
+	enc.AddBytesKey("rawURL", b.rawURL, base64.RawURLEncoding)
+}
+
+func (b *testBytes) IsNil() bool {
+	return b == nil
+}
+
+func TestEncodeBytes(t *testing.T) {
+	t.Parallel()
+
+	data := []byte{0xfb, 0xff, 0xfe, 'g', 'o'}
+	b := &strings.Builder{}
+	enc := NewEncoder(b)
+	require.NoError(t, enc.EncodeObject(&testBytes{std: data, url: data, raw: data, rawURL: data}))
+	assert.Equal(t, `{"std":"+//+Z28=","url":"-__-Z28=","raw":"+//+Z28","rawURL":"-__-Z28"}`, b.String())
+
+	expected, err := json.Marshal(map[string][]byte{"std": data})
+	require.NoError(t, err)
+	b.Reset()
+	require.NoError(t, enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+		enc.AddBytesKey("std", data, base64.StdEncoding)
+	})))
+	assert.Equal(t, string(expected), b.String(), "the standard encoding is the one of encoding/json")
+
+	b.Reset()
+	require.NoError(t, enc.EncodeObject(&testBytes{}))
+	assert.Equal(t, `{"std":"","raw":null,"rawURL":""}`, b.String())
+
+	b.Reset()
+	require.NoError(t, enc.EncodeArray(EncodeArrayFunc(func(enc *Encoder) {
+		enc.Bytes([]byte("a"), nil)
+		enc.AddBytes(nil, nil)
+		enc.BytesOmitEmpty(nil, nil)
+		enc.AddBytesOmitEmpty([]byte("b"), base64.RawStdEncoding)
+		enc.BytesNullEmpty(nil, nil)
+		enc.AddBytesNullEmpty([]byte("c"), nil)
+	})))
+	assert.Equal(t, `["YQ==","","Yg",null,"Yw=="]`, b.String())
+
+	b.Reset()
+	require.NoError(t, enc.EncodeBytes([]byte("hello"), base64.URLEncoding))
+	assert.Equal(t, `"aGVsbG8="`, b.String())
+}
+
+func TestBytesRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	data := make([]byte, 256)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	v := testBytes{std: data, url: data, raw: data, rawURL: data}
+	b, err := MarshalJSONObject(&v)
+	require.NoError(t, err)
+
+	var decoded testBytes
+	require.NoError(t, Unmarshal(b, &decoded))
+	assert.Equal(t, v, decoded)
+}
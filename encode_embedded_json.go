@@ -6,7 +6,12 @@ func (enc *Encoder) EncodeEmbeddedJSON(v *EmbeddedJSON) error {
 	if enc.isPooled == 1 {
 		panic(InvalidUsagePooledEncoderError("Invalid usage of pooled encoder"))
 	}
-	enc.buf = *v
+	if enc.htmlSafe {
+		enc.buf = make([]byte, 0, len(*v)+16)
+		enc.writeEmbeddedJSON(*v)
+	} else {
+		enc.buf = *v
+	}
 	_, err := enc.Write()
 	if err != nil {
 		return err
@@ -15,7 +20,7 @@ func (enc *Encoder) EncodeEmbeddedJSON(v *EmbeddedJSON) error {
 }
 
 func (enc *Encoder) encodeEmbeddedJSON(v *EmbeddedJSON) ([]byte, error) {
-	enc.writeBytes(*v)
+	enc.writeEmbeddedJSON(*v)
 	return enc.buf, nil
 }
 
@@ -29,7 +34,7 @@ func (enc *Encoder) AddEmbeddedJSON(v *EmbeddedJSON) {
 	if r != '[' {
 		enc.writeByte(',')
 	}
-	enc.writeBytes(*v)
+	enc.writeEmbeddedJSON(*v)
 }
 
 // AddEmbeddedJSONOmitEmpty adds an EmbeddedJSON to be encoded or skips it if nil pointer or empty.
@@ -44,7 +49,7 @@ func (enc *Encoder) AddEmbeddedJSONOmitEmpty(v *EmbeddedJSON) {
 	if r != '[' {
 		enc.writeByte(',')
 	}
-	enc.writeBytes(*v)
+	enc.writeEmbeddedJSON(*v)
 }
 
 // AddEmbeddedJSONKey adds an EmbeddedJSON and a key to be encoded.
@@ -65,7 +70,7 @@ func (enc *Encoder) AddEmbeddedJSONKey(key string, v *EmbeddedJSON) {
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
 	enc.writeBytes(objKey)
-	enc.writeBytes(*v)
+	enc.writeEmbeddedJSON(*v)
 }
 
 // AddEmbeddedJSONKeyOmitEmpty adds an EmbeddedJSON and a key to be encoded or skips it if nil pointer or empty.
@@ -89,5 +94,5 @@ func (enc *Encoder) AddEmbeddedJSONKeyOmitEmpty(key string, v *EmbeddedJSON) {
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
 	enc.writeBytes(objKey)
-	enc.writeBytes(*v)
+	enc.writeEmbeddedJSON(*v)
 }
@@ -0,0 +1,77 @@
+package gojay
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testIndentObject struct{}
+
+func (o *testIndentObject) MarshalJSONObject(enc *Encoder) {
+	enc.StringKey("str", `a "{[,:]}" \ b`)
+	enc.IntKey("int", 1)
+	enc.ObjectKey("user", &testSliceUser{id: 2, name: "b"})
+	enc.ObjectKey("empty", &testSliceUser{})
+	enc.ArrayKey("users", EncodeSlice([]*testSliceUser{{id: 1}, {id: 2}}, EncodeObjectPtr[testSliceUser]))
+	enc.ArrayKey("none", EncodeSlice([]int{}, (*Encoder).Int))
+	enc.ArrayKey("nested", EncodeSlice([][]int{{1, 2}, {}}, EncodeSliceOf((*Encoder).Int)))
+	enc.AddEmbeddedJSONKey("raw", &EmbeddedJSON{' ', '{', ' ', '"', 'a', '"', ' ', ':', '[', '1', ',', ' ', '2', ']', '}'})
+	enc.BoolKey("bool", true)
+}
+
+func (o *testIndentObject) IsNil() bool {
+	return o == nil
+}
+
+func TestMarshalIndent(t *testing.T) {
+	t.Parallel()
+
+	b, err := Marshal(&testIndentObject{})
+	require.NoError(t, err)
+
+	for _, indent := range []struct{ prefix, indent string }{{"", "  "}, {"> ", "\t"}, {"", ""}} {
+		expected, err := json.MarshalIndent(json.RawMessage(b), indent.prefix, indent.indent)
+		require.NoError(t, err)
+
+		got, err := MarshalIndent(&testIndentObject{}, indent.prefix, indent.indent)
+		require.NoError(t, err)
+		assert.Equal(t, string(expected), string(got))
+	}
+
+	got, err := MarshalIndent(EncodeSlice([]string{"a", "b"}, (*Encoder).String), "", " ")
+	require.NoError(t, err)
+	assert.Equal(t, "[\n \"a\",\n \"b\"\n]", string(got))
+
+	got, err = MarshalIndent("str", "", " ")
+	require.NoError(t, err)
+	assert.Equal(t, `"str"`, string(got))
+
+	_, err = MarshalIndent(struct{}{}, "", " ")
+	assert.IsType(t, InvalidMarshalError(""), err)
+}
+
+func TestEncoderWithIndent(t *testing.T) {
+	t.Parallel()
+
+	builder := &strings.Builder{}
+	enc := BorrowEncoder(builder, WithIndent("", "  "))
+	defer enc.Release()
+	require.NoError(t, enc.EncodeObject(&testSliceUser{id: 1, name: "a"}))
+	assert.Equal(t, "{\n  \"id\": 1,\n  \"name\": \"a\"\n}", builder.String())
+
+	builder.Reset()
+	v := EmbeddedJSON(`{"a": [ ]}`)
+	require.NoError(t, enc.EncodeEmbeddedJSON(&v))
+	assert.Equal(t, "{\n  \"a\": []\n}", builder.String())
+	assert.Equal(t, `{"a": [ ]}`, string(v), "the embedded JSON is not modified")
+
+	builder.Reset()
+	enc = BorrowEncoder(builder)
+	defer enc.Release()
+	require.NoError(t, enc.EncodeObject(&testSliceUser{id: 1, name: "a"}))
+	assert.Equal(t, `{"id":1,"name":"a"}`, builder.String(), "a borrowed encoder is not indented")
+}
@@ -1,6 +1,7 @@
 package gojay
 
 import (
+	"encoding/json"
 	"fmt"
 )
 
@@ -43,6 +44,14 @@ func (enc *Encoder) Encode(v any) error {
 		return enc.EncodeFloat(vt)
 	case float32:
 		return enc.EncodeFloat32(vt)
+	case Number:
+		return enc.EncodeNumber(vt)
+	case json.Number:
+		return enc.EncodeNumber(Number(vt))
+	case map[string]any:
+		return enc.EncodeObject(interfaceObject(vt))
+	case []any:
+		return enc.EncodeArray(interfaceArray(vt))
 	case *EmbeddedJSON:
 		return enc.EncodeEmbeddedJSON(vt)
 	default:
@@ -50,6 +59,35 @@ func (enc *Encoder) Encode(v any) error {
 	}
 }
 
+// interfaceObject encodes an object decoded to an any, like by Decoder.Interface or Decoder.MapStringAny,
+// its keys are sorted like encoding/json does.
+func interfaceObject(m map[string]any) MarshalerJSONObject {
+	return EncodeMap(m, encodeInterfaceKey).Sorted()
+}
+
+// interfaceArray encodes an array decoded to an any.
+func interfaceArray(s []any) MarshalerJSONArray {
+	return EncodeSlice(s, encodeInterface)
+}
+
+// encodeInterface and encodeInterfaceKey encode the values of the objects and arrays decoded to an any,
+// contrary to AddInterface and AddInterfaceKey a nil value is a JSON null which is kept.
+func encodeInterface(enc *Encoder, v any) {
+	if v == nil {
+		enc.AddNull()
+		return
+	}
+	enc.AddInterface(v)
+}
+
+func encodeInterfaceKey(enc *Encoder, key string, v any) {
+	if v == nil {
+		enc.AddNullKey(key)
+		return
+	}
+	enc.AddInterfaceKey(key, v)
+}
+
 // AddInterface adds an any to be encoded, must be used inside a slice or array encoding (does not encode a key).
 //
 //nolint:cyclop
@@ -83,6 +121,14 @@ func (enc *Encoder) AddInterface(value any) {
 		enc.AddFloat(vt)
 	case float32:
 		enc.AddFloat32(vt)
+	case Number:
+		enc.AddNumber(vt)
+	case json.Number:
+		enc.AddNumber(Number(vt))
+	case map[string]any:
+		enc.AddObject(interfaceObject(vt))
+	case []any:
+		enc.AddArray(interfaceArray(vt))
 	default:
 		if vt != nil {
 			enc.err = InvalidMarshalError(fmt.Sprintf(invalidMarshalErrorMsg, vt))
@@ -127,6 +173,14 @@ func (enc *Encoder) AddInterfaceKey(key string, value any) {
 		enc.AddFloatKey(key, vt)
 	case float32:
 		enc.AddFloat32Key(key, vt)
+	case Number:
+		enc.AddNumberKey(key, vt)
+	case json.Number:
+		enc.AddNumberKey(key, Number(vt))
+	case map[string]any:
+		enc.AddObjectKey(key, interfaceObject(vt))
+	case []any:
+		enc.AddArrayKey(key, interfaceArray(vt))
 	default:
 		if vt != nil {
 			enc.err = InvalidMarshalError(fmt.Sprintf(invalidMarshalErrorMsg, vt))
@@ -171,6 +225,14 @@ func (enc *Encoder) AddInterfaceKeyOmitEmpty(key string, v any) {
 		enc.AddFloatKeyOmitEmpty(key, vt)
 	case float32:
 		enc.AddFloat32KeyOmitEmpty(key, vt)
+	case Number:
+		enc.AddNumberKeyOmitEmpty(key, vt)
+	case json.Number:
+		enc.AddNumberKeyOmitEmpty(key, Number(vt))
+	case map[string]any:
+		enc.AddObjectKeyOmitEmpty(key, interfaceObject(vt))
+	case []any:
+		enc.AddArrayKeyOmitEmpty(key, interfaceArray(vt))
 	default:
 		if vt != nil {
 			enc.err = InvalidMarshalError(fmt.Sprintf(invalidMarshalErrorMsg, vt))
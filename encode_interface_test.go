@@ -1,6 +1,7 @@
 package gojay
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
@@ -187,3 +188,43 @@ func TestEncoderInterfaceMarshalAPI(t *testing.T) {
 		}
 	})
 }
+
+func TestEncoderInterfaceRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	input := `{"a":1.5,"b":"x","c":[1,{"d":[true,null,"y"]},[]],"e":{"f":{},"g":null},"h":-12345678901234567890}`
+
+	t.Run("interface", func(t *testing.T) {
+		t.Parallel()
+
+		var v any
+		require.NoError(t, Unmarshal([]byte(input), &v))
+		b, err := Marshal(v)
+		require.NoError(t, err)
+		assert.JSONEq(t, strings.Replace(input, "-12345678901234567890", "-12345678901234567000", 1), string(b))
+	})
+	t.Run("map-string-any-use-number", func(t *testing.T) {
+		t.Parallel()
+
+		dec := NewDecoder(strings.NewReader(input))
+		dec.UseNumber()
+		var m map[string]any
+		require.NoError(t, dec.MapStringAny(&m))
+		require.IsType(t, json.Number(""), m["h"])
+		b, err := Marshal(m)
+		require.NoError(t, err)
+		// the keys are sorted, numbers are kept as they are
+		assert.Equal(t, input, string(b))
+		b, err = Marshal(EncodeMap(m, (*Encoder).AddInterfaceKey).Sorted())
+		require.NoError(t, err)
+		assert.Equal(t, input, string(b))
+	})
+	t.Run("encode-slice", func(t *testing.T) {
+		t.Parallel()
+
+		builder := &strings.Builder{}
+		enc := NewEncoder(builder)
+		require.NoError(t, enc.Encode([]any{map[string]any{"a": []any{json.Number("1e3")}}, nil, "x"}))
+		assert.Equal(t, `[{"a":[1e3]},null,"x"]`, builder.String())
+	})
+}
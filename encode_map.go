@@ -0,0 +1,52 @@
+package gojay
+
+import "slices"
+
+// MapEncoder is a MarshalerJSONObject encoding a map with string keys, see EncodeMap.
+type MapEncoder[K ~string, V any] struct {
+	m           map[K]V
+	encodeValue func(enc *Encoder, key string, v V)
+	sorted      bool
+}
+
+// EncodeMap returns a MarshalerJSONObject encoding m, each entry being encoded with encodeValue.
+// Method expressions of the Encoder encoding a key can be used as encodeValue,
+// like (*Encoder).StringKey or (*Encoder).IntKeyOmitEmpty.
+//
+// Entries are encoded in the iteration order of the map, call Sorted for a deterministic output.
+//
+// Example:
+//
+//	enc.ObjectKey("labels", gojay.EncodeMap(labels, (*gojay.Encoder).StringKey).Sorted())
+func EncodeMap[K ~string, V any](m map[K]V, encodeValue func(enc *Encoder, key string, v V)) MapEncoder[K, V] {
+	return MapEncoder[K, V]{m: m, encodeValue: encodeValue}
+}
+
+// Sorted returns a MapEncoder encoding the entries in the order of their keys.
+func (m MapEncoder[K, V]) Sorted() MapEncoder[K, V] {
+	m.sorted = true
+	return m
+}
+
+// MarshalJSONObject implements MarshalerJSONObject.
+func (m MapEncoder[K, V]) MarshalJSONObject(enc *Encoder) {
+	if !m.sorted {
+		for k, v := range m.m {
+			m.encodeValue(enc, string(k), v)
+		}
+		return
+	}
+	keys := make([]K, 0, len(m.m))
+	for k := range m.m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		m.encodeValue(enc, string(k), m.m[k])
+	}
+}
+
+// IsNil implements MarshalerJSONObject, it returns true if the map is nil.
+func (m MapEncoder[K, V]) IsNil() bool {
+	return m.m == nil
+}
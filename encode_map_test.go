@@ -0,0 +1,51 @@
+package gojay
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeMap(t *testing.T) {
+	t.Parallel()
+
+	labels := map[string]string{"team": "core", "env": "prod", "app": "api"}
+	counts := map[testLabel]int{"b": 2, "a": 0, "c": 3}
+
+	b := &strings.Builder{}
+	enc := NewEncoder(b)
+	err := enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+		enc.ObjectKey("labels", EncodeMap(labels, (*Encoder).StringKey).Sorted())
+		enc.ObjectKey("counts", EncodeMap(counts, (*Encoder).IntKeyOmitEmpty).Sorted())
+		enc.ObjectKeyOmitEmpty("missing", EncodeMap(map[string]any(nil), (*Encoder).AddInterfaceKey))
+		enc.ArrayKey("list", EncodeArrayFunc(func(enc *Encoder) {
+			enc.Object(EncodeMap(map[string]any{"x": 1.5}, (*Encoder).AddInterfaceKey))
+		}))
+	}))
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		`{"labels":{"app":"api","env":"prod","team":"core"},"counts":{"b":2,"c":3},"list":[{"x":1.5}]}`,
+		b.String(),
+	)
+
+	data, err := Marshal(EncodeMap(map[string]string{"k": "v"}, (*Encoder).StringKey))
+	require.NoError(t, err)
+	assert.Equal(t, `{"k":"v"}`, string(data))
+}
+
+func TestEncodeMapRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	m := map[string]any{"a": "1", "b": 2.5, "c": true}
+	data, err := Marshal(EncodeMap(m, (*Encoder).AddInterfaceKey))
+	require.NoError(t, err)
+
+	var decoded map[string]any
+	dec := BorrowDecoder(strings.NewReader(string(data)))
+	defer dec.Release()
+	require.NoError(t, dec.MapStringAny(&decoded))
+	assert.Equal(t, m, decoded)
+}
@@ -1 +1,162 @@
 package gojay
+
+import "fmt"
+
+const invalidNumberErrorMsg = "Invalid number %q, it does not follow the JSON number grammar"
+
+// EncodeNumber encodes a Number to JSON, an empty Number is encoded as 0.
+// A Number which does not follow the JSON number grammar returns an InvalidMarshalError.
+func (enc *Encoder) EncodeNumber(n Number) error {
+	if enc.isPooled == 1 {
+		panic(InvalidUsagePooledEncoderError("Invalid usage of pooled encoder"))
+	}
+	enc.appendNumber(n)
+	if enc.err != nil {
+		return enc.err
+	}
+	_, err := enc.Write()
+	if err != nil {
+		enc.err = err
+		return err
+	}
+	return nil
+}
+
+// AddNumber adds a Number to be encoded, must be used inside a slice or array encoding (does not encode a key).
+// An empty Number is encoded as 0.
+func (enc *Encoder) AddNumber(v Number) {
+	enc.Number(v)
+}
+
+// AddNumberOmitEmpty adds a Number to be encoded and skips it if it is empty,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) AddNumberOmitEmpty(v Number) {
+	enc.NumberOmitEmpty(v)
+}
+
+// AddNumberNullEmpty adds a Number to be encoded and encodes null if it is empty,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) AddNumberNullEmpty(v Number) {
+	enc.NumberNullEmpty(v)
+}
+
+// Number adds a Number to be encoded, must be used inside a slice or array encoding (does not encode a key).
+// An empty Number is encoded as 0.
+func (enc *Encoder) Number(v Number) {
+	enc.grow(len(v) + 1)
+	r := enc.getPreviousRune()
+	if r != '[' {
+		enc.writeByte(',')
+	}
+	enc.appendNumber(v)
+}
+
+// NumberOmitEmpty adds a Number to be encoded and skips it if it is empty,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) NumberOmitEmpty(v Number) {
+	if v == "" {
+		return
+	}
+	enc.Number(v)
+}
+
+// NumberNullEmpty adds a Number to be encoded and encodes null if it is empty,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) NumberNullEmpty(v Number) {
+	enc.grow(len(v) + 5)
+	r := enc.getPreviousRune()
+	if r != '[' {
+		enc.writeByte(',')
+	}
+	if v == "" {
+		enc.writeBytes(nullBytes)
+		return
+	}
+	enc.appendNumber(v)
+}
+
+// AddNumberKey adds a Number to be encoded, must be used inside an object as it will encode a key.
+// An empty Number is encoded as 0.
+func (enc *Encoder) AddNumberKey(key string, v Number) {
+	enc.NumberKey(key, v)
+}
+
+// AddNumberKeyOmitEmpty adds a Number to be encoded and skips it if it is empty.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) AddNumberKeyOmitEmpty(key string, v Number) {
+	enc.NumberKeyOmitEmpty(key, v)
+}
+
+// AddNumberKeyNullEmpty adds a Number to be encoded and encodes null if it is empty.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) AddNumberKeyNullEmpty(key string, v Number) {
+	enc.NumberKeyNullEmpty(key, v)
+}
+
+// NumberKey adds a Number to be encoded, must be used inside an object as it will encode a key.
+// An empty Number is encoded as 0.
+func (enc *Encoder) NumberKey(key string, v Number) {
+	if enc.hasKeys {
+		if !enc.keyExists(key) {
+			return
+		}
+	}
+	enc.grow(len(key) + len(v) + 4)
+	r := enc.getPreviousRune()
+	if r != '{' {
+		enc.writeByte(',')
+	}
+	enc.writeByte('"')
+	enc.writeStringEscape(key)
+	enc.writeBytes(objKey)
+	enc.appendNumber(v)
+}
+
+// NumberKeyOmitEmpty adds a Number to be encoded and skips it if it is empty.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) NumberKeyOmitEmpty(key string, v Number) {
+	if v == "" {
+		return
+	}
+	enc.NumberKey(key, v)
+}
+
+// NumberKeyNullEmpty adds a Number to be encoded and encodes null if it is empty.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) NumberKeyNullEmpty(key string, v Number) {
+	if enc.hasKeys {
+		if !enc.keyExists(key) {
+			return
+		}
+	}
+	enc.grow(len(key) + len(v) + 8)
+	r := enc.getPreviousRune()
+	if r != '{' {
+		enc.writeByte(',')
+	}
+	enc.writeByte('"')
+	enc.writeStringEscape(key)
+	enc.writeBytes(objKey)
+	if v == "" {
+		enc.writeBytes(nullBytes)
+		return
+	}
+	enc.appendNumber(v)
+}
+
+// appendNumber appends the literal of n to the buffer, 0 if it is empty.
+// A Number which does not follow the JSON number grammar is encoded as null
+// and sets an InvalidMarshalError on the Encoder.
+func (enc *Encoder) appendNumber(n Number) {
+	switch {
+	case n == "":
+		enc.writeByte('0')
+	case n.Valid():
+		enc.writeString(string(n))
+	default:
+		if enc.err == nil {
+			enc.err = InvalidMarshalError(fmt.Sprintf(invalidNumberErrorMsg, string(n)))
+		}
+		enc.writeBytes(nullBytes)
+	}
+}
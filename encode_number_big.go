@@ -0,0 +1,378 @@
+package gojay
+
+import (
+	"fmt"
+	"math/big"
+)
+
+const invalidBigNumberErrorMsg = "Invalid big number %s, it has no JSON representation"
+
+// EncodeBigInt encodes a *big.Int to JSON, nil is encoded as null.
+func (enc *Encoder) EncodeBigInt(v *big.Int) error {
+	if enc.isPooled == 1 {
+		panic(InvalidUsagePooledEncoderError("Invalid usage of pooled encoder"))
+	}
+	enc.appendBigNumber(v)
+	if enc.err != nil {
+		return enc.err
+	}
+	_, err := enc.Write()
+	if err != nil {
+		enc.err = err
+		return err
+	}
+	return nil
+}
+
+// AddBigInt adds a *big.Int to be encoded, must be used inside a slice or array encoding (does not encode a key).
+// nil is encoded as null.
+func (enc *Encoder) AddBigInt(v *big.Int) {
+	enc.BigInt(v)
+}
+
+// AddBigIntOmitEmpty adds a *big.Int to be encoded and skips it if it is nil or 0,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) AddBigIntOmitEmpty(v *big.Int) {
+	enc.BigIntOmitEmpty(v)
+}
+
+// AddBigIntNullEmpty adds a *big.Int to be encoded and encodes null if it is nil or 0,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) AddBigIntNullEmpty(v *big.Int) {
+	enc.BigIntNullEmpty(v)
+}
+
+// BigInt adds a *big.Int to be encoded, must be used inside a slice or array encoding (does not encode a key).
+// nil is encoded as null.
+func (enc *Encoder) BigInt(v *big.Int) {
+	enc.bigNumber(v, false, false)
+}
+
+// BigIntOmitEmpty adds a *big.Int to be encoded and skips it if it is nil or 0,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) BigIntOmitEmpty(v *big.Int) {
+	enc.bigNumber(v, true, false)
+}
+
+// BigIntNullEmpty adds a *big.Int to be encoded and encodes null if it is nil or 0,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) BigIntNullEmpty(v *big.Int) {
+	enc.bigNumber(v, false, true)
+}
+
+// AddBigIntKey adds a *big.Int to be encoded, must be used inside an object as it will encode a key.
+// nil is encoded as null.
+func (enc *Encoder) AddBigIntKey(key string, v *big.Int) {
+	enc.BigIntKey(key, v)
+}
+
+// AddBigIntKeyOmitEmpty adds a *big.Int to be encoded and skips it if it is nil or 0.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) AddBigIntKeyOmitEmpty(key string, v *big.Int) {
+	enc.BigIntKeyOmitEmpty(key, v)
+}
+
+// AddBigIntKeyNullEmpty adds a *big.Int to be encoded and encodes null if it is nil or 0.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) AddBigIntKeyNullEmpty(key string, v *big.Int) {
+	enc.BigIntKeyNullEmpty(key, v)
+}
+
+// BigIntKey adds a *big.Int to be encoded, must be used inside an object as it will encode a key.
+// nil is encoded as null.
+func (enc *Encoder) BigIntKey(key string, v *big.Int) {
+	enc.bigNumberKey(key, v, false, false)
+}
+
+// BigIntKeyOmitEmpty adds a *big.Int to be encoded and skips it if it is nil or 0.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) BigIntKeyOmitEmpty(key string, v *big.Int) {
+	enc.bigNumberKey(key, v, true, false)
+}
+
+// BigIntKeyNullEmpty adds a *big.Int to be encoded and encodes null if it is nil or 0.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) BigIntKeyNullEmpty(key string, v *big.Int) {
+	enc.bigNumberKey(key, v, false, true)
+}
+
+// EncodeBigFloat encodes a *big.Float to JSON, nil is encoded as null.
+//
+// An infinite value has no JSON representation, it is encoded as null and an InvalidMarshalError is returned.
+func (enc *Encoder) EncodeBigFloat(v *big.Float) error {
+	if enc.isPooled == 1 {
+		panic(InvalidUsagePooledEncoderError("Invalid usage of pooled encoder"))
+	}
+	enc.appendBigNumber(v)
+	if enc.err != nil {
+		return enc.err
+	}
+	_, err := enc.Write()
+	if err != nil {
+		enc.err = err
+		return err
+	}
+	return nil
+}
+
+// AddBigFloat adds a *big.Float to be encoded, must be used inside a slice or array encoding (does not encode a key).
+// nil is encoded as null.
+func (enc *Encoder) AddBigFloat(v *big.Float) {
+	enc.BigFloat(v)
+}
+
+// AddBigFloatOmitEmpty adds a *big.Float to be encoded and skips it if it is nil or 0,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) AddBigFloatOmitEmpty(v *big.Float) {
+	enc.BigFloatOmitEmpty(v)
+}
+
+// AddBigFloatNullEmpty adds a *big.Float to be encoded and encodes null if it is nil or 0,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) AddBigFloatNullEmpty(v *big.Float) {
+	enc.BigFloatNullEmpty(v)
+}
+
+// BigFloat adds a *big.Float to be encoded, must be used inside a slice or array encoding (does not encode a key).
+// nil is encoded as null.
+func (enc *Encoder) BigFloat(v *big.Float) {
+	enc.bigNumber(v, false, false)
+}
+
+// BigFloatOmitEmpty adds a *big.Float to be encoded and skips it if it is nil or 0,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) BigFloatOmitEmpty(v *big.Float) {
+	enc.bigNumber(v, true, false)
+}
+
+// BigFloatNullEmpty adds a *big.Float to be encoded and encodes null if it is nil or 0,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) BigFloatNullEmpty(v *big.Float) {
+	enc.bigNumber(v, false, true)
+}
+
+// AddBigFloatKey adds a *big.Float to be encoded, must be used inside an object as it will encode a key.
+// nil is encoded as null.
+func (enc *Encoder) AddBigFloatKey(key string, v *big.Float) {
+	enc.BigFloatKey(key, v)
+}
+
+// AddBigFloatKeyOmitEmpty adds a *big.Float to be encoded and skips it if it is nil or 0.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) AddBigFloatKeyOmitEmpty(key string, v *big.Float) {
+	enc.BigFloatKeyOmitEmpty(key, v)
+}
+
+// AddBigFloatKeyNullEmpty adds a *big.Float to be encoded and encodes null if it is nil or 0.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) AddBigFloatKeyNullEmpty(key string, v *big.Float) {
+	enc.BigFloatKeyNullEmpty(key, v)
+}
+
+// BigFloatKey adds a *big.Float to be encoded, must be used inside an object as it will encode a key.
+// nil is encoded as null.
+func (enc *Encoder) BigFloatKey(key string, v *big.Float) {
+	enc.bigNumberKey(key, v, false, false)
+}
+
+// BigFloatKeyOmitEmpty adds a *big.Float to be encoded and skips it if it is nil or 0.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) BigFloatKeyOmitEmpty(key string, v *big.Float) {
+	enc.bigNumberKey(key, v, true, false)
+}
+
+// BigFloatKeyNullEmpty adds a *big.Float to be encoded and encodes null if it is nil or 0.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) BigFloatKeyNullEmpty(key string, v *big.Float) {
+	enc.bigNumberKey(key, v, false, true)
+}
+
+// EncodeBigRat encodes a *big.Rat to JSON, nil is encoded as null.
+//
+// The number is encoded exactly in decimal notation. A rational with no finite decimal representation, like 1/3,
+// is encoded as null and an InvalidMarshalError is returned.
+func (enc *Encoder) EncodeBigRat(v *big.Rat) error {
+	if enc.isPooled == 1 {
+		panic(InvalidUsagePooledEncoderError("Invalid usage of pooled encoder"))
+	}
+	enc.appendBigNumber(v)
+	if enc.err != nil {
+		return enc.err
+	}
+	_, err := enc.Write()
+	if err != nil {
+		enc.err = err
+		return err
+	}
+	return nil
+}
+
+// AddBigRat adds a *big.Rat to be encoded, must be used inside a slice or array encoding (does not encode a key).
+// nil is encoded as null.
+func (enc *Encoder) AddBigRat(v *big.Rat) {
+	enc.BigRat(v)
+}
+
+// AddBigRatOmitEmpty adds a *big.Rat to be encoded and skips it if it is nil or 0,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) AddBigRatOmitEmpty(v *big.Rat) {
+	enc.BigRatOmitEmpty(v)
+}
+
+// AddBigRatNullEmpty adds a *big.Rat to be encoded and encodes null if it is nil or 0,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) AddBigRatNullEmpty(v *big.Rat) {
+	enc.BigRatNullEmpty(v)
+}
+
+// BigRat adds a *big.Rat to be encoded, must be used inside a slice or array encoding (does not encode a key).
+// nil is encoded as null.
+func (enc *Encoder) BigRat(v *big.Rat) {
+	enc.bigNumber(v, false, false)
+}
+
+// BigRatOmitEmpty adds a *big.Rat to be encoded and skips it if it is nil or 0,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) BigRatOmitEmpty(v *big.Rat) {
+	enc.bigNumber(v, true, false)
+}
+
+// BigRatNullEmpty adds a *big.Rat to be encoded and encodes null if it is nil or 0,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) BigRatNullEmpty(v *big.Rat) {
+	enc.bigNumber(v, false, true)
+}
+
+// AddBigRatKey adds a *big.Rat to be encoded, must be used inside an object as it will encode a key.
+// nil is encoded as null.
+func (enc *Encoder) AddBigRatKey(key string, v *big.Rat) {
+	enc.BigRatKey(key, v)
+}
+
+// AddBigRatKeyOmitEmpty adds a *big.Rat to be encoded and skips it if it is nil or 0.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) AddBigRatKeyOmitEmpty(key string, v *big.Rat) {
+	enc.BigRatKeyOmitEmpty(key, v)
+}
+
+// AddBigRatKeyNullEmpty adds a *big.Rat to be encoded and encodes null if it is nil or 0.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) AddBigRatKeyNullEmpty(key string, v *big.Rat) {
+	enc.BigRatKeyNullEmpty(key, v)
+}
+
+// BigRatKey adds a *big.Rat to be encoded, must be used inside an object as it will encode a key.
+// nil is encoded as null.
+func (enc *Encoder) BigRatKey(key string, v *big.Rat) {
+	enc.bigNumberKey(key, v, false, false)
+}
+
+// BigRatKeyOmitEmpty adds a *big.Rat to be encoded and skips it if it is nil or 0.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) BigRatKeyOmitEmpty(key string, v *big.Rat) {
+	enc.bigNumberKey(key, v, true, false)
+}
+
+// BigRatKeyNullEmpty adds a *big.Rat to be encoded and encodes null if it is nil or 0.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) BigRatKeyNullEmpty(key string, v *big.Rat) {
+	enc.bigNumberKey(key, v, false, true)
+}
+
+// bigNumber adds v, a *big.Int, *big.Float or *big.Rat, to be encoded inside a slice or array encoding.
+func (enc *Encoder) bigNumber(v any, omitEmpty, nullEmpty bool) {
+	empty := isEmptyBigNumber(v)
+	if omitEmpty && empty {
+		return
+	}
+	enc.grow(10)
+	r := enc.getPreviousRune()
+	if r != '[' {
+		enc.writeByte(',')
+	}
+	if nullEmpty && empty {
+		enc.writeBytes(nullBytes)
+		return
+	}
+	enc.appendBigNumber(v)
+}
+
+// bigNumberKey adds v, a *big.Int, *big.Float or *big.Rat, to be encoded inside an object with the given key.
+func (enc *Encoder) bigNumberKey(key string, v any, omitEmpty, nullEmpty bool) {
+	if enc.hasKeys {
+		if !enc.keyExists(key) {
+			return
+		}
+	}
+	empty := isEmptyBigNumber(v)
+	if omitEmpty && empty {
+		return
+	}
+	enc.grow(10 + len(key))
+	r := enc.getPreviousRune()
+	if r != '{' {
+		enc.writeByte(',')
+	}
+	enc.writeByte('"')
+	enc.writeStringEscape(key)
+	enc.writeBytes(objKey)
+	if nullEmpty && empty {
+		enc.writeBytes(nullBytes)
+		return
+	}
+	enc.appendBigNumber(v)
+}
+
+// isEmptyBigNumber reports whether v, a *big.Int, *big.Float or *big.Rat, is nil or 0.
+func isEmptyBigNumber(v any) bool {
+	switch vt := v.(type) {
+	case *big.Int:
+		return vt == nil || vt.Sign() == 0
+	case *big.Float:
+		return vt == nil || vt.Sign() == 0
+	case *big.Rat:
+		return vt == nil || vt.Sign() == 0
+	}
+	return true
+}
+
+// appendBigNumber appends the JSON number of v, a *big.Int, *big.Float or *big.Rat, to the buffer.
+// nil and numbers with no JSON representation are encoded as null,
+// the latter setting an InvalidMarshalError on the Encoder.
+func (enc *Encoder) appendBigNumber(v any) {
+	switch vt := v.(type) {
+	case *big.Int:
+		if vt != nil {
+			enc.buf = vt.Append(enc.buf, 10)
+			return
+		}
+	case *big.Float:
+		if vt != nil && !vt.IsInf() {
+			// like encoding/json, the exponent notation is only used below about 1e-6 and above about 1e21
+			if exp := vt.MantExp(nil); vt.Sign() != 0 && (exp < -19 || exp > 70) {
+				enc.buf = vt.Append(enc.buf, 'e', -1)
+			} else {
+				enc.buf = vt.Append(enc.buf, 'f', -1)
+			}
+			return
+		}
+		if vt != nil {
+			enc.setBigNumberErr(vt)
+		}
+	case *big.Rat:
+		if vt != nil {
+			if prec, exact := vt.FloatPrec(); exact {
+				enc.buf = append(enc.buf, vt.FloatString(prec)...)
+				return
+			}
+			enc.setBigNumberErr(vt)
+		}
+	}
+	enc.writeBytes(nullBytes)
+}
+
+func (enc *Encoder) setBigNumberErr(v fmt.Stringer) {
+	if enc.err == nil {
+		enc.err = InvalidMarshalError(fmt.Sprintf(invalidBigNumberErrorMsg, v.String()))
+	}
+}
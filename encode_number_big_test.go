@@ -0,0 +1,105 @@
+package gojay
+
+import (
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncoderBigNumbers(t *testing.T) {
+	t.Parallel()
+
+	amount, _ := new(big.Int).SetString("-340282366920938463463374607431768211455", 10)
+	rate, _, _ := big.ParseFloat("0.1000000000000000000000000000001", 10, 128, big.ToNearestEven)
+	var nilInt *big.Int
+	zero := new(big.Float)
+
+	b := &strings.Builder{}
+	enc := NewEncoder(b)
+	err := enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+		enc.BigIntKey("amount", amount)
+		enc.AddBigIntKey("nil", nilInt)
+		enc.BigIntKeyOmitEmpty("omitted", nilInt)
+		enc.AddBigIntKeyNullEmpty("zero", new(big.Int))
+		enc.BigFloatKey("rate", rate)
+		enc.AddBigFloatKeyOmitEmpty("omitted", zero)
+		enc.BigFloatKeyNullEmpty("zeroFloat", zero)
+		enc.BigRatKey("ratio", big.NewRat(-3, 2000))
+		enc.AddBigRatKeyOmitEmpty("omitted", new(big.Rat))
+		enc.BigRatKeyNullEmpty("integer", big.NewRat(42, 1))
+		enc.ArrayKey("items", EncodeArrayFunc(func(enc *Encoder) {
+			enc.BigInt(big.NewInt(1))
+			enc.AddBigIntOmitEmpty(new(big.Int))
+			enc.BigIntNullEmpty(nilInt)
+			enc.AddBigFloat(big.NewFloat(1e300))
+			enc.BigFloatOmitEmpty(big.NewFloat(1e-7))
+			enc.AddBigFloatNullEmpty(big.NewFloat(2.5))
+			enc.BigRat(big.NewRat(1, 8))
+			enc.AddBigRatOmitEmpty(big.NewRat(-1, 4))
+			enc.BigRatNullEmpty(new(big.Rat))
+		}))
+	}))
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		`{"amount":-340282366920938463463374607431768211455,"nil":null,"zero":null,"rate":0.1000000000000000000000000000001,`+
+			`"zeroFloat":null,"ratio":-0.0015,"integer":42,"items":[1,null,1e+300,1e-07,2.5,0.125,-0.25,null]}`,
+		b.String(),
+	)
+}
+
+func TestEncoderBigNumbersWithKeys(t *testing.T) {
+	t.Parallel()
+
+	b := &strings.Builder{}
+	enc := NewEncoder(b)
+	err := enc.EncodeObjectKeys(EncodeObjectFunc(func(enc *Encoder) {
+		enc.BigIntKey("a", big.NewInt(1))
+		enc.BigFloatKeyOmitEmpty("b", big.NewFloat(2))
+		enc.BigRatKeyNullEmpty("c", big.NewRat(3, 1))
+	}), []string{"a", "c"})
+	require.NoError(t, err)
+	assert.Equal(t, `{"a":1,"c":3}`, b.String())
+}
+
+func TestEncoderBigNumbersTopLevel(t *testing.T) {
+	t.Parallel()
+
+	b := &strings.Builder{}
+	enc := NewEncoder(b)
+	require.NoError(t, enc.EncodeBigInt(big.NewInt(-7)))
+	assert.Equal(t, "-7", b.String())
+
+	b.Reset()
+	enc = NewEncoder(b)
+	require.NoError(t, enc.EncodeBigFloat(big.NewFloat(0.5)))
+	assert.Equal(t, "0.5", b.String())
+
+	b.Reset()
+	enc = NewEncoder(b)
+	require.NoError(t, enc.EncodeBigRat(big.NewRat(5, 4)))
+	assert.Equal(t, "1.25", b.String())
+}
+
+func TestEncoderBigNumbersErrors(t *testing.T) {
+	t.Parallel()
+
+	b := &strings.Builder{}
+	enc := NewEncoder(b)
+	err := enc.EncodeBigRat(big.NewRat(1, 3))
+	require.ErrorAs(t, err, new(InvalidMarshalError))
+	assert.Equal(t, "Invalid big number 1/3, it has no JSON representation", err.Error())
+
+	b.Reset()
+	enc = NewEncoder(b)
+	err = enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+		enc.BigFloatKey("inf", big.NewFloat(math.Inf(1)))
+		enc.BigRatKey("nil", nil)
+	}))
+	require.ErrorAs(t, err, new(InvalidMarshalError))
+	assert.Contains(t, err.Error(), "+Inf")
+}
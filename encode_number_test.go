@@ -558,3 +558,63 @@ func TestUint64Add(t *testing.T) {
 		assert.Equal(t, `[`, builder.String(), `builder.String() should be equal to {"test":10"`)
 	})
 }
+
+func TestEncoderNumber(t *testing.T) {
+	t.Parallel()
+
+	b := &strings.Builder{}
+	enc := NewEncoder(b)
+	err := enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+		enc.NumberKey("id", "123456789012345678901234567890")
+		enc.AddNumberKey("empty", "")
+		enc.NumberKeyOmitEmpty("omitted", "")
+		enc.AddNumberKeyOmitEmpty("amount", "-0.10")
+		enc.NumberKeyNullEmpty("null", "")
+		enc.AddNumberKeyNullEmpty("exp", "1E+2")
+		enc.AddInterfaceKey("any", Number("7"))
+		enc.ArrayKey("items", EncodeArrayFunc(func(enc *Encoder) {
+			enc.Number("1")
+			enc.AddNumber("")
+			enc.NumberOmitEmpty("")
+			enc.AddNumberOmitEmpty("2.5")
+			enc.NumberNullEmpty("")
+			enc.AddNumberNullEmpty("3")
+			enc.AddInterface(Number("4"))
+		}))
+	}))
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		`{"id":123456789012345678901234567890,"empty":0,"amount":-0.10,"null":null,"exp":1E+2,"any":7,"items":[1,0,2.5,null,3,4]}`,
+		b.String(),
+	)
+
+	b.Reset()
+	enc = NewEncoder(b)
+	require.NoError(t, enc.Encode(Number("-1e-3")))
+	assert.Equal(t, "-1e-3", b.String())
+
+	data, err := Marshal(Number("42"))
+	require.NoError(t, err)
+	assert.Equal(t, "42", string(data))
+}
+
+func TestEncoderNumberInvalid(t *testing.T) {
+	t.Parallel()
+
+	b := &strings.Builder{}
+	enc := NewEncoder(b)
+	err := enc.EncodeNumber("1,5")
+	require.ErrorAs(t, err, new(InvalidMarshalError))
+	assert.Equal(t, `Invalid number "1,5", it does not follow the JSON number grammar`, err.Error())
+
+	_, err = Marshal(Number("NaN"))
+	require.ErrorAs(t, err, new(InvalidMarshalError))
+
+	b.Reset()
+	enc = NewEncoder(b)
+	err = enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+		enc.NumberKey("a", "0x10")
+	}))
+	require.ErrorAs(t, err, new(InvalidMarshalError))
+}
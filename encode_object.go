@@ -49,7 +49,7 @@ func (enc *Encoder) encodeObject(v MarshalerJSONObject) ([]byte, error) {
 	enc.grow(512)
 	enc.writeByte('{')
 	if !v.IsNil() {
-		v.MarshalJSONObject(enc)
+		enc.marshalObject(v)
 	}
 	if enc.hasKeys {
 		enc.hasKeys = false
@@ -124,7 +124,7 @@ func (enc *Encoder) Object(v MarshalerJSONObject) {
 	enc.hasKeys = false
 	enc.keys = nil
 
-	v.MarshalJSONObject(enc)
+	enc.marshalObject(v)
 
 	enc.hasKeys = origHasKeys
 	enc.keys = origKeys
@@ -157,7 +157,7 @@ func (enc *Encoder) ObjectWithKeys(v MarshalerJSONObject, keys []string) {
 	enc.hasKeys = true
 	enc.keys = keys
 
-	v.MarshalJSONObject(enc)
+	enc.marshalObject(v)
 
 	enc.hasKeys = origHasKeys
 	enc.keys = origKeys
@@ -184,7 +184,7 @@ func (enc *Encoder) ObjectOmitEmpty(v MarshalerJSONObject) {
 	enc.hasKeys = false
 	enc.keys = nil
 
-	v.MarshalJSONObject(enc)
+	enc.marshalObject(v)
 
 	enc.hasKeys = origHasKeys
 	enc.keys = origKeys
@@ -212,7 +212,7 @@ func (enc *Encoder) ObjectNullEmpty(v MarshalerJSONObject) {
 	enc.hasKeys = false
 	enc.keys = nil
 
-	v.MarshalJSONObject(enc)
+	enc.marshalObject(v)
 
 	enc.hasKeys = origHasKeys
 	enc.keys = origKeys
@@ -254,7 +254,7 @@ func (enc *Encoder) ObjectKey(key string, v MarshalerJSONObject) {
 	enc.hasKeys = false
 	enc.keys = nil
 
-	v.MarshalJSONObject(enc)
+	enc.marshalObject(v)
 
 	enc.hasKeys = origHasKeys
 	enc.keys = origKeys
@@ -294,7 +294,7 @@ func (enc *Encoder) ObjectKeyWithKeys(key string, value MarshalerJSONObject, key
 	origHasKeys := enc.hasKeys
 	enc.hasKeys = true
 	enc.keys = keys
-	value.MarshalJSONObject(enc)
+	enc.marshalObject(value)
 	enc.hasKeys = origHasKeys
 	enc.keys = origKeys
 	enc.writeByte('}')
@@ -326,7 +326,7 @@ func (enc *Encoder) ObjectKeyOmitEmpty(key string, v MarshalerJSONObject) {
 	enc.hasKeys = false
 	enc.keys = nil
 
-	v.MarshalJSONObject(enc)
+	enc.marshalObject(v)
 
 	enc.hasKeys = origHasKeys
 	enc.keys = origKeys
@@ -362,7 +362,7 @@ func (enc *Encoder) ObjectKeyNullEmpty(key string, v MarshalerJSONObject) {
 	enc.hasKeys = false
 	enc.keys = nil
 
-	v.MarshalJSONObject(enc)
+	enc.marshalObject(v)
 
 	enc.hasKeys = origHasKeys
 	enc.keys = origKeys
@@ -0,0 +1,61 @@
+package gojay
+
+// EncoderOption configures an Encoder,
+// options are given to NewEncoder, BorrowEncoder or Decoder.Reformat.
+type EncoderOption func(enc *Encoder)
+
+// WithIndent makes the Encoder write indented JSON like json.MarshalIndent:
+// each element of an object or an array begins on a new line starting with prefix
+// followed by one copy of indent per nesting level.
+// Empty objects and arrays are written as {} and [].
+//
+// The values are encoded as usual in the buffer of the Encoder, which is indented as it is written to the io.Writer.
+func WithIndent(prefix, indent string) EncoderOption {
+	return func(enc *Encoder) {
+		enc.indented = true
+		enc.prefix = prefix
+		enc.indent = indent
+	}
+}
+
+// WithHTMLSafe makes the Encoder escape <, > and & in strings as \u003c, \u003e and \u0026,
+// as well as the line and paragraph separators U+2028 and U+2029 as \u2028 and \u2029,
+// so that the JSON can be embedded in an HTML <script> tag or in JavaScript.
+// Keys, values and EmbeddedJSON are escaped, the JSON being semantically the same.
+func WithHTMLSafe() EncoderOption {
+	return func(enc *Encoder) {
+		enc.htmlSafe = true
+	}
+}
+
+// EncoderUTF8Policy defines how an Encoder with WithEncoderUTF8Policy handles strings which are not valid UTF-8.
+type EncoderUTF8Policy int
+
+const (
+	// EncodeUTF8Unchecked writes strings as they are, invalid UTF-8 producing invalid JSON. It is the default.
+	EncodeUTF8Unchecked EncoderUTF8Policy = iota
+	// EncodeUTF8Replace replaces each invalid byte with U+FFFD, like encoding/json.
+	EncodeUTF8Replace
+	// EncodeUTF8Reject fails the encoding with an InvalidUTF8Error on an invalid byte.
+	EncodeUTF8Reject
+	// EncodeUTF8Escape escapes each invalid byte as \u00XX, XX being the value of the byte.
+	// The escape sequence is the code point U+00XX, decoding the JSON gives the byte read as Latin-1
+	// encoded in UTF-8, not the original byte.
+	EncodeUTF8Escape
+)
+
+// WithEncoderUTF8Policy sets how the Encoder handles strings which are not valid UTF-8, keys included.
+// With EncodeUTF8Replace each invalid byte is replaced with U+FFFD, with EncodeUTF8Escape it is escaped as \u00XX,
+// which decodes to U+00XX, and with EncodeUTF8Reject the encoding fails with an InvalidUTF8Error.
+// By default, with EncodeUTF8Unchecked, strings are written as they are.
+func WithEncoderUTF8Policy(p EncoderUTF8Policy) EncoderOption {
+	return func(enc *Encoder) {
+		enc.utf8Policy = p
+	}
+}
+
+func (enc *Encoder) applyOptions(opts []EncoderOption) {
+	for _, opt := range opts {
+		opt(enc)
+	}
+}
@@ -17,13 +17,16 @@ func init() {
 	}
 }
 
-// NewEncoder returns a new encoder or borrows one from the pool.
-func NewEncoder(w io.Writer) *Encoder {
-	return &Encoder{w: w}
+// NewEncoder returns a new encoder.
+// It takes an io.Writer implementation as output and options configuring the encoder.
+func NewEncoder(w io.Writer, opts ...EncoderOption) *Encoder {
+	enc := &Encoder{w: w}
+	enc.applyOptions(opts)
+	return enc
 }
 
-// BorrowEncoder borrows an Encoder from the pool.
-func BorrowEncoder(w io.Writer) *Encoder {
+// BorrowEncoder borrows an Encoder from the pool, opts configuring it.
+func BorrowEncoder(w io.Writer, opts ...EncoderOption) *Encoder {
 	//nolint:forcetypeassert
 	enc := encPool.Get().(*Encoder)
 	enc.w = w
@@ -32,6 +35,12 @@ func BorrowEncoder(w io.Writer) *Encoder {
 	enc.err = nil
 	enc.hasKeys = false
 	enc.keys = nil
+	enc.indented = false
+	enc.prefix = ""
+	enc.indent = ""
+	enc.htmlSafe = false
+	enc.utf8Policy = EncodeUTF8Unchecked
+	enc.applyOptions(opts)
 	return enc
 }
 
@@ -0,0 +1,153 @@
+package gojay
+
+import "io"
+
+// reformatFlushSize is the size of the buffer above which Reformat writes it to the io.Writer.
+const reformatFlushSize = 4096
+
+// Compact appends to dst the JSON-encoded src without insignificant space characters and returns the extended buffer.
+// Strings are escaped again like the Encoder does, numbers are kept as they are.
+//
+// If src is not valid JSON, a *DecodeError locating the failure is returned with dst unchanged.
+// Several values separated by spaces are written one per line.
+func Compact(dst, src []byte) ([]byte, error) {
+	return reformatBytes(dst, src)
+}
+
+// Indent appends to dst the JSON-encoded src indented like MarshalIndent and returns the extended buffer,
+// each element of an object or an array beginning on a new line starting with prefix
+// followed by one copy of indent per nesting level.
+// Strings are escaped again like the Encoder does, numbers are kept as they are.
+//
+// If src is not valid JSON, a *DecodeError locating the failure is returned with dst unchanged.
+// Several values separated by spaces are written one after the other, separated by a new line.
+func Indent(dst, src []byte, prefix, indent string) ([]byte, error) {
+	return reformatBytes(dst, src, WithIndent(prefix, indent))
+}
+
+func reformatBytes(dst, src []byte, opts ...EncoderOption) ([]byte, error) {
+	dec := borrowDecoder(nil, 0)
+	defer dec.Release()
+	// strings are unescaped in the buffer, src must not be modified
+	dec.data = make([]byte, len(src))
+	copy(dec.data, src)
+	dec.length = len(src)
+
+	enc := BorrowEncoder(nil, opts...)
+	defer func() {
+		enc.buf = make([]byte, 0, 512)
+		enc.Release()
+	}()
+	enc.buf = dst
+	n, err := enc.reformat(dec)
+	if err != nil {
+		return dst, err
+	}
+	if n == 0 {
+		return dst, dec.raiseUnexpectedErr(dec.cursor, "value")
+	}
+	return enc.buf, nil
+}
+
+// Reformat reads the JSON values of the input of the Decoder until its end and writes them to w,
+// compacted or indented if opts has WithIndent, one value per line.
+// Strings are escaped again like the Encoder does, numbers are kept as they are.
+//
+// The input is read token by token and the output is written as it goes,
+// the memory used doesn't depend on the size of the input.
+// Reformatting stops at the first error, a *DecodeError locating the failure if the input is not valid JSON,
+// what was reformatted before being written to w.
+func (dec *Decoder) Reformat(w io.Writer, opts ...EncoderOption) error {
+	enc := BorrowEncoder(w, opts...)
+	defer enc.Release()
+	_, err := enc.reformat(dec)
+	if len(enc.buf) > 0 {
+		if _, werr := enc.w.Write(enc.buf); werr != nil && err == nil {
+			err = werr
+		}
+		enc.buf = enc.buf[:0]
+	}
+	return err
+}
+
+// reformat writes the tokens read by dec to the buffer of the Encoder and returns the number of top level values.
+// If the Encoder has an io.Writer, the buffer is written to it as it grows.
+//
+//nolint:cyclop
+func (enc *Encoder) reformat(dec *Decoder) (int, error) {
+	values := 0
+	depth := 0
+	// open is true after the start of an object or an array until its first element,
+	// afterKey is true after a key, its value being written on the same line
+	open, afterKey := false, false
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return values, nil
+		} else if err != nil {
+			return values, err
+		}
+		switch tok.Kind {
+		case KindObjectEnd, KindArrayEnd:
+			depth--
+			if enc.indented && !open {
+				enc.buf = appendNewline(enc.buf, enc.prefix, enc.indent, depth)
+			}
+			open = false
+			if tok.Kind == KindObjectEnd {
+				enc.writeByte('}')
+			} else {
+				enc.writeByte(']')
+			}
+		default:
+			switch {
+			case afterKey:
+			case depth > 0:
+				if !open {
+					enc.writeByte(',')
+				}
+				if enc.indented {
+					enc.buf = appendNewline(enc.buf, enc.prefix, enc.indent, depth)
+				}
+			case values > 0:
+				enc.writeByte('\n')
+			}
+			open, afterKey = false, false
+			switch tok.Kind {
+			case KindObjectStart:
+				enc.writeByte('{')
+				depth++
+				open = true
+			case KindArrayStart:
+				enc.writeByte('[')
+				depth++
+				open = true
+			case KindKey:
+				enc.writeByte('"')
+				enc.writeStringEscape(tok.Value)
+				enc.writeTwoBytes('"', ':')
+				if enc.indented {
+					enc.writeByte(' ')
+				}
+				afterKey = true
+			case KindString:
+				enc.writeByte('"')
+				enc.writeStringEscape(tok.Value)
+				enc.writeByte('"')
+			case KindNull:
+				enc.writeBytes(nullBytes)
+			default:
+				enc.writeString(tok.Value)
+			}
+		}
+		if depth == 0 {
+			values++
+		}
+		if enc.w != nil && len(enc.buf) >= reformatFlushSize {
+			if _, err := enc.w.Write(enc.buf); err != nil {
+				return values, err
+			}
+			enc.buf = enc.buf[:0]
+		}
+	}
+}
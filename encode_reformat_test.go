@@ -0,0 +1,155 @@
+package gojay
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testReformatJSON = ` {
+	"str": "a \"{[,:]}\" \\ b", "num": -1.5e10, "int" : 1,
+	"obj": {"a": [1, {}, [], {"b": null}], "c": true, "d": false},
+	"empty": { }, "arr": [ ]
+} `
+
+func TestCompactIndent(t *testing.T) {
+	t.Parallel()
+
+	var expected bytes.Buffer
+	require.NoError(t, json.Compact(&expected, []byte(testReformatJSON)))
+	got, err := Compact([]byte("prefix:"), []byte(testReformatJSON))
+	require.NoError(t, err)
+	assert.Equal(t, "prefix:"+expected.String(), string(got))
+
+	for _, indent := range []struct{ prefix, indent string }{{"", "  "}, {"> ", "\t"}, {"", ""}} {
+		expected.Reset()
+		require.NoError(t, json.Indent(&expected, []byte(strings.TrimSpace(testReformatJSON)), indent.prefix, indent.indent))
+		got, err := Indent(nil, []byte(testReformatJSON), indent.prefix, indent.indent)
+		require.NoError(t, err)
+		assert.Equal(t, expected.String(), string(got))
+	}
+
+	got, err = Compact(nil, []byte(`"é\u0001\/" 1 [ ]`))
+	require.NoError(t, err)
+	assert.Equal(t, "\"é\\u0001/\"\n1\n[]", string(got), "strings are escaped again and values are written one per line")
+
+	src := []byte(`{"a\n": "A"}`)
+	_, err = Compact(nil, src)
+	require.NoError(t, err)
+	assert.Equal(t, `{"a\n": "A"}`, string(src), "src is not modified")
+}
+
+func TestCompactIndentErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		input  string
+		offset int
+	}{
+		{name: "missing-colon", input: `{"a" 1}`, offset: 5},
+		{name: "unclosed", input: `{"a": [1, 2}`, offset: 11},
+		{name: "truncated", input: `{"a": [1, 2`, offset: 11},
+		{name: "invalid-literal", input: `[tru]`, offset: 4},
+		{name: "empty", input: ` `, offset: 1},
+		{name: "missing-comma-array", input: `[1 2]`, offset: 3},
+		{name: "missing-comma-object", input: `{"a": 1 "b": 2}`, offset: 8},
+		{name: "doubled-comma-array", input: `[1,,2]`, offset: 3},
+		{name: "doubled-comma-object", input: `{"a": 1,, "b": 2}`, offset: 8},
+		{name: "leading-comma-array", input: `[,1]`, offset: 1},
+		{name: "leading-comma-object", input: `{, "a": 1}`, offset: 1},
+		{name: "trailing-comma-array", input: `[1, ]`, offset: 4},
+		{name: "trailing-comma-object", input: `{"a": 1,}`, offset: 8},
+		{name: "top-level-comma", input: `1, 2`, offset: 1},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			dst := []byte("dst")
+			got, err := Indent(dst, []byte(testCase.input), "", " ")
+			var decErr *DecodeError
+			require.ErrorAs(t, err, &decErr)
+			assert.Equal(t, testCase.offset, decErr.Offset)
+			assert.Equal(t, "dst", string(got))
+
+			got, err = Compact(dst, []byte(testCase.input))
+			require.ErrorAs(t, err, &decErr)
+			assert.Equal(t, testCase.offset, decErr.Offset)
+			assert.Equal(t, "dst", string(got))
+		})
+	}
+}
+
+func TestDecoderReformat(t *testing.T) {
+	t.Parallel()
+
+	var b strings.Builder
+	dec := NewDecoder(iotest.OneByteReader(strings.NewReader(testReformatJSON + testReformatJSON)))
+	require.NoError(t, dec.Reformat(&b, WithIndent("", "\t")))
+	var expected bytes.Buffer
+	require.NoError(t, json.Indent(&expected, []byte(strings.TrimSpace(testReformatJSON)), "", "\t"))
+	assert.Equal(t, expected.String()+"\n"+expected.String(), b.String())
+
+	b.Reset()
+	dec = NewDecoder(strings.NewReader(`{"a": [1, 2}`))
+	var decErr *DecodeError
+	require.ErrorAs(t, dec.Reformat(&b), &decErr)
+	assert.Equal(t, "$.a[1]", decErr.Path)
+	assert.Equal(t, `{"a":[1,2`, b.String(), "what was reformatted is written")
+
+	b.Reset()
+	dec = NewDecoder(strings.NewReader(`{"a": 1} , {"b": 2}`))
+	require.ErrorAs(t, dec.Reformat(&b), &decErr, "top level values are not separated by commas")
+	assert.Equal(t, 9, decErr.Offset)
+
+	errWrite := errors.New("write")
+	dec = NewDecoder(strings.NewReader(`[1]`))
+	assert.ErrorIs(t, dec.Reformat(errWriter{errWrite}), errWrite)
+}
+
+func TestDecoderReformatLarge(t *testing.T) {
+	t.Parallel()
+
+	const n = 100000
+	elems := make([]io.Reader, 0, n+2)
+	elems = append(elems, strings.NewReader(`{"items": [`))
+	elem := strings.NewReader(`{"id": 1, "name": "xxxxxxxxxxxxxxxxxxxx"}, `)
+	for range n {
+		elems = append(elems, io.NewSectionReader(elem, 0, elem.Size()))
+	}
+	elems = append(elems, strings.NewReader(`null]}`))
+
+	dec := BorrowDecoder(io.MultiReader(elems...))
+	defer dec.Release()
+	w := &countWriter{}
+	require.NoError(t, dec.Reformat(w))
+	assert.Equal(t, len(`{"items":[`)+n*len(`{"id":1,"name":"xxxxxxxxxxxxxxxxxxxx"},`)+len(`null]}`), w.n)
+	assert.Less(t, cap(dec.data), 1<<16, "the input is not buffered")
+	assert.LessOrEqual(t, w.max, reformatFlushSize+64, "the output is not buffered")
+}
+
+type errWriter struct {
+	err error
+}
+
+func (w errWriter) Write([]byte) (int, error) {
+	return 0, w.err
+}
+
+type countWriter struct {
+	n, max int
+}
+
+func (w *countWriter) Write(p []byte) (int, error) {
+	w.n += len(p)
+	w.max = max(w.max, len(p))
+	return len(p), nil
+}
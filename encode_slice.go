@@ -1,5 +1,55 @@
 package gojay
 
+// SliceEncoder is a MarshalerJSONArray encoding a slice, see EncodeSlice.
+type SliceEncoder[T any] struct {
+	s          []T
+	encodeElem func(enc *Encoder, v T)
+}
+
+// EncodeSlice returns a MarshalerJSONArray encoding s, each element being encoded with encodeElem.
+// Method expressions of the Encoder can be used as encodeElem, like (*Encoder).String or (*Encoder).Float32,
+// EncodeObjectPtr encodes a []*T of objects and EncodeSliceOf a [][]T.
+// Fixed-size arrays are encoded through their slice.
+//
+// Example:
+//
+//	enc.ArrayKey("users", gojay.EncodeSlice(users, gojay.EncodeObjectPtr[user]))
+func EncodeSlice[T any](s []T, encodeElem func(enc *Encoder, v T)) SliceEncoder[T] {
+	return SliceEncoder[T]{s: s, encodeElem: encodeElem}
+}
+
+// EncodeSliceOf returns a function encoding a slice with EncodeSlice and encodeElem,
+// it is used to encode nested slices.
+//
+// Example:
+//
+//	enc.ArrayKey("matrix", gojay.EncodeSlice(matrix, gojay.EncodeSliceOf((*gojay.Encoder).Float64)))
+func EncodeSliceOf[T any](encodeElem func(enc *Encoder, v T)) func(enc *Encoder, s []T) {
+	return func(enc *Encoder, s []T) {
+		enc.Array(EncodeSlice(s, encodeElem))
+	}
+}
+
+// EncodeObjectPtr encodes the object v, it is meant to be used with EncodeSlice to encode a []*T.
+func EncodeObjectPtr[T any, PT interface {
+	*T
+	MarshalerJSONObject
+}](enc *Encoder, v *T) {
+	enc.Object(PT(v))
+}
+
+// MarshalJSONArray implements MarshalerJSONArray.
+func (s SliceEncoder[T]) MarshalJSONArray(enc *Encoder) {
+	for _, v := range s.s {
+		s.encodeElem(enc, v)
+	}
+}
+
+// IsNil implements MarshalerJSONArray, it returns true if the slice is nil.
+func (s SliceEncoder[T]) IsNil() bool {
+	return s.s == nil
+}
+
 // AddSliceString marshals the given []string s.
 func (enc *Encoder) AddSliceString(s []string) {
 	enc.SliceString(s)
@@ -7,11 +57,7 @@ func (enc *Encoder) AddSliceString(s []string) {
 
 // SliceString marshals the given []string s.
 func (enc *Encoder) SliceString(s []string) {
-	enc.Array(EncodeArrayFunc(func(enc *Encoder) {
-		for _, str := range s {
-			enc.String(str)
-		}
-	}))
+	enc.Array(EncodeSlice(s, (*Encoder).String))
 }
 
 // AddSliceStringKey marshals the given []string s.
@@ -21,11 +67,7 @@ func (enc *Encoder) AddSliceStringKey(k string, s []string) {
 
 // SliceStringKey marshals the given []string s.
 func (enc *Encoder) SliceStringKey(k string, s []string) {
-	enc.ArrayKey(k, EncodeArrayFunc(func(enc *Encoder) {
-		for _, str := range s {
-			enc.String(str)
-		}
-	}))
+	enc.ArrayKey(k, EncodeSlice(s, (*Encoder).String))
 }
 
 // AddSliceInt marshals the given []int s.
@@ -35,11 +77,7 @@ func (enc *Encoder) AddSliceInt(s []int) {
 
 // SliceInt marshals the given []int s.
 func (enc *Encoder) SliceInt(s []int) {
-	enc.Array(EncodeArrayFunc(func(enc *Encoder) {
-		for _, i := range s {
-			enc.Int(i)
-		}
-	}))
+	enc.Array(EncodeSlice(s, (*Encoder).Int))
 }
 
 // AddSliceIntKey marshals the given []int s.
@@ -49,11 +87,187 @@ func (enc *Encoder) AddSliceIntKey(k string, s []int) {
 
 // SliceIntKey marshals the given []int s.
 func (enc *Encoder) SliceIntKey(k string, s []int) {
-	enc.ArrayKey(k, EncodeArrayFunc(func(enc *Encoder) {
-		for _, i := range s {
-			enc.Int(i)
-		}
-	}))
+	enc.ArrayKey(k, EncodeSlice(s, (*Encoder).Int))
+}
+
+// AddSliceInt8 marshals the given []int8 s.
+func (enc *Encoder) AddSliceInt8(s []int8) {
+	enc.SliceInt8(s)
+}
+
+// SliceInt8 marshals the given []int8 s.
+func (enc *Encoder) SliceInt8(s []int8) {
+	enc.Array(EncodeSlice(s, (*Encoder).Int8))
+}
+
+// AddSliceInt8Key marshals the given []int8 s.
+func (enc *Encoder) AddSliceInt8Key(k string, s []int8) {
+	enc.SliceInt8Key(k, s)
+}
+
+// SliceInt8Key marshals the given []int8 s.
+func (enc *Encoder) SliceInt8Key(k string, s []int8) {
+	enc.ArrayKey(k, EncodeSlice(s, (*Encoder).Int8))
+}
+
+// AddSliceInt16 marshals the given []int16 s.
+func (enc *Encoder) AddSliceInt16(s []int16) {
+	enc.SliceInt16(s)
+}
+
+// SliceInt16 marshals the given []int16 s.
+func (enc *Encoder) SliceInt16(s []int16) {
+	enc.Array(EncodeSlice(s, (*Encoder).Int16))
+}
+
+// AddSliceInt16Key marshals the given []int16 s.
+func (enc *Encoder) AddSliceInt16Key(k string, s []int16) {
+	enc.SliceInt16Key(k, s)
+}
+
+// SliceInt16Key marshals the given []int16 s.
+func (enc *Encoder) SliceInt16Key(k string, s []int16) {
+	enc.ArrayKey(k, EncodeSlice(s, (*Encoder).Int16))
+}
+
+// AddSliceInt32 marshals the given []int32 s.
+func (enc *Encoder) AddSliceInt32(s []int32) {
+	enc.SliceInt32(s)
+}
+
+// SliceInt32 marshals the given []int32 s.
+func (enc *Encoder) SliceInt32(s []int32) {
+	enc.Array(EncodeSlice(s, (*Encoder).Int32))
+}
+
+// AddSliceInt32Key marshals the given []int32 s.
+func (enc *Encoder) AddSliceInt32Key(k string, s []int32) {
+	enc.SliceInt32Key(k, s)
+}
+
+// SliceInt32Key marshals the given []int32 s.
+func (enc *Encoder) SliceInt32Key(k string, s []int32) {
+	enc.ArrayKey(k, EncodeSlice(s, (*Encoder).Int32))
+}
+
+// AddSliceInt64 marshals the given []int64 s.
+func (enc *Encoder) AddSliceInt64(s []int64) {
+	enc.SliceInt64(s)
+}
+
+// SliceInt64 marshals the given []int64 s.
+func (enc *Encoder) SliceInt64(s []int64) {
+	enc.Array(EncodeSlice(s, (*Encoder).Int64))
+}
+
+// AddSliceInt64Key marshals the given []int64 s.
+func (enc *Encoder) AddSliceInt64Key(k string, s []int64) {
+	enc.SliceInt64Key(k, s)
+}
+
+// SliceInt64Key marshals the given []int64 s.
+func (enc *Encoder) SliceInt64Key(k string, s []int64) {
+	enc.ArrayKey(k, EncodeSlice(s, (*Encoder).Int64))
+}
+
+// AddSliceUint8 marshals the given []uint8 s.
+func (enc *Encoder) AddSliceUint8(s []uint8) {
+	enc.SliceUint8(s)
+}
+
+// SliceUint8 marshals the given []uint8 s.
+func (enc *Encoder) SliceUint8(s []uint8) {
+	enc.Array(EncodeSlice(s, (*Encoder).Uint8))
+}
+
+// AddSliceUint8Key marshals the given []uint8 s.
+func (enc *Encoder) AddSliceUint8Key(k string, s []uint8) {
+	enc.SliceUint8Key(k, s)
+}
+
+// SliceUint8Key marshals the given []uint8 s.
+func (enc *Encoder) SliceUint8Key(k string, s []uint8) {
+	enc.ArrayKey(k, EncodeSlice(s, (*Encoder).Uint8))
+}
+
+// AddSliceUint16 marshals the given []uint16 s.
+func (enc *Encoder) AddSliceUint16(s []uint16) {
+	enc.SliceUint16(s)
+}
+
+// SliceUint16 marshals the given []uint16 s.
+func (enc *Encoder) SliceUint16(s []uint16) {
+	enc.Array(EncodeSlice(s, (*Encoder).Uint16))
+}
+
+// AddSliceUint16Key marshals the given []uint16 s.
+func (enc *Encoder) AddSliceUint16Key(k string, s []uint16) {
+	enc.SliceUint16Key(k, s)
+}
+
+// SliceUint16Key marshals the given []uint16 s.
+func (enc *Encoder) SliceUint16Key(k string, s []uint16) {
+	enc.ArrayKey(k, EncodeSlice(s, (*Encoder).Uint16))
+}
+
+// AddSliceUint32 marshals the given []uint32 s.
+func (enc *Encoder) AddSliceUint32(s []uint32) {
+	enc.SliceUint32(s)
+}
+
+// SliceUint32 marshals the given []uint32 s.
+func (enc *Encoder) SliceUint32(s []uint32) {
+	enc.Array(EncodeSlice(s, (*Encoder).Uint32))
+}
+
+// AddSliceUint32Key marshals the given []uint32 s.
+func (enc *Encoder) AddSliceUint32Key(k string, s []uint32) {
+	enc.SliceUint32Key(k, s)
+}
+
+// SliceUint32Key marshals the given []uint32 s.
+func (enc *Encoder) SliceUint32Key(k string, s []uint32) {
+	enc.ArrayKey(k, EncodeSlice(s, (*Encoder).Uint32))
+}
+
+// AddSliceUint64 marshals the given []uint64 s.
+func (enc *Encoder) AddSliceUint64(s []uint64) {
+	enc.SliceUint64(s)
+}
+
+// SliceUint64 marshals the given []uint64 s.
+func (enc *Encoder) SliceUint64(s []uint64) {
+	enc.Array(EncodeSlice(s, (*Encoder).Uint64))
+}
+
+// AddSliceUint64Key marshals the given []uint64 s.
+func (enc *Encoder) AddSliceUint64Key(k string, s []uint64) {
+	enc.SliceUint64Key(k, s)
+}
+
+// SliceUint64Key marshals the given []uint64 s.
+func (enc *Encoder) SliceUint64Key(k string, s []uint64) {
+	enc.ArrayKey(k, EncodeSlice(s, (*Encoder).Uint64))
+}
+
+// AddSliceFloat32 marshals the given []float32 s.
+func (enc *Encoder) AddSliceFloat32(s []float32) {
+	enc.SliceFloat32(s)
+}
+
+// SliceFloat32 marshals the given []float32 s.
+func (enc *Encoder) SliceFloat32(s []float32) {
+	enc.Array(EncodeSlice(s, (*Encoder).Float32))
+}
+
+// AddSliceFloat32Key marshals the given []float32 s.
+func (enc *Encoder) AddSliceFloat32Key(k string, s []float32) {
+	enc.SliceFloat32Key(k, s)
+}
+
+// SliceFloat32Key marshals the given []float32 s.
+func (enc *Encoder) SliceFloat32Key(k string, s []float32) {
+	enc.ArrayKey(k, EncodeSlice(s, (*Encoder).Float32))
 }
 
 // AddSliceFloat64 marshals the given []float64 s.
@@ -63,11 +277,7 @@ func (enc *Encoder) AddSliceFloat64(s []float64) {
 
 // SliceFloat64 marshals the given []float64 s.
 func (enc *Encoder) SliceFloat64(s []float64) {
-	enc.Array(EncodeArrayFunc(func(enc *Encoder) {
-		for _, i := range s {
-			enc.Float64(i)
-		}
-	}))
+	enc.Array(EncodeSlice(s, (*Encoder).Float64))
 }
 
 // AddSliceFloat64Key marshals the given []float64 s.
@@ -77,11 +287,7 @@ func (enc *Encoder) AddSliceFloat64Key(k string, s []float64) {
 
 // SliceFloat64Key marshals the given []float64 s.
 func (enc *Encoder) SliceFloat64Key(k string, s []float64) {
-	enc.ArrayKey(k, EncodeArrayFunc(func(enc *Encoder) {
-		for _, i := range s {
-			enc.Float64(i)
-		}
-	}))
+	enc.ArrayKey(k, EncodeSlice(s, (*Encoder).Float64))
 }
 
 // AddSliceBool marshals the given []bool s.
@@ -91,11 +297,7 @@ func (enc *Encoder) AddSliceBool(s []bool) {
 
 // SliceBool marshals the given []bool s.
 func (enc *Encoder) SliceBool(s []bool) {
-	enc.Array(EncodeArrayFunc(func(enc *Encoder) {
-		for _, i := range s {
-			enc.Bool(i)
-		}
-	}))
+	enc.Array(EncodeSlice(s, (*Encoder).Bool))
 }
 
 // AddSliceBoolKey marshals the given []bool s.
@@ -105,9 +307,5 @@ func (enc *Encoder) AddSliceBoolKey(k string, s []bool) {
 
 // SliceBoolKey marshals the given []bool s.
 func (enc *Encoder) SliceBoolKey(k string, s []bool) {
-	enc.ArrayKey(k, EncodeArrayFunc(func(enc *Encoder) {
-		for _, i := range s {
-			enc.Bool(i)
-		}
-	}))
+	enc.ArrayKey(k, EncodeSlice(s, (*Encoder).Bool))
 }
@@ -194,3 +194,66 @@ func TestEncodeSliceSlices(t *testing.T) {
 		)
 	}
 }
+
+func (u *testSliceUser) MarshalJSONObject(enc *Encoder) {
+	enc.IntKey("id", u.id)
+	enc.StringKeyOmitEmpty("name", u.name)
+}
+
+func (u *testSliceUser) IsNil() bool {
+	return u == nil
+}
+
+func TestEncodeSliceGeneric(t *testing.T) {
+	t.Parallel()
+
+	point := [3]float64{1, 2.5, -3}
+	b := &strings.Builder{}
+	enc := NewEncoder(b)
+	err := enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+		enc.SliceInt8Key("int8s", []int8{-128, 127})
+		enc.AddSliceInt16Key("int16s", []int16{-32768, 32767})
+		enc.SliceInt32Key("int32s", []int32{-2147483648, 2147483647})
+		enc.AddSliceInt64Key("int64s", []int64{-9223372036854775808, 9223372036854775807})
+		enc.SliceUint8Key("uint8s", []uint8{255})
+		enc.AddSliceUint16Key("uint16s", []uint16{65535})
+		enc.SliceUint32Key("uint32s", []uint32{4294967295})
+		enc.AddSliceUint64Key("uint64s", []uint64{18446744073709551615})
+		enc.SliceFloat32Key("float32s", []float32{1.5, -2.25})
+		enc.SliceFloat32Key("nil", nil)
+		enc.ArrayKey("users", EncodeSlice([]*testSliceUser{{id: 1, name: "a"}, {id: 2}}, EncodeObjectPtr[testSliceUser]))
+		enc.ArrayKey("matrix", EncodeSlice([][]int{{1, 2}, nil, {3}}, EncodeSliceOf((*Encoder).Int)))
+		enc.ArrayKey("point", EncodeSlice(point[:], (*Encoder).Float64))
+		enc.ArrayKey("list", EncodeArrayFunc(func(enc *Encoder) {
+			enc.SliceInt64([]int64{1})
+			enc.AddSliceUint32([]uint32{2})
+		}))
+	}))
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		`{"int8s":[-128,127],"int16s":[-32768,32767],"int32s":[-2147483648,2147483647],`+
+			`"int64s":[-9223372036854775808,9223372036854775807],"uint8s":[255],"uint16s":[65535],`+
+			`"uint32s":[4294967295],"uint64s":[18446744073709551615],"float32s":[1.5,-2.25],"nil":[],`+
+			`"users":[{"id":1,"name":"a"},{"id":2}],"matrix":[[1,2],[],[3]],"point":[1,2.5,-3],"list":[[1],[2]]}`,
+		b.String(),
+	)
+
+	data, err := Marshal(EncodeSlice([]string{"a", "b"}, (*Encoder).String))
+	require.NoError(t, err)
+	assert.Equal(t, `["a","b"]`, string(data))
+}
+
+func TestSliceRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	users := []*testSliceUser{{id: 1, name: "a"}, {id: 2, name: "b"}}
+	data, err := Marshal(EncodeSlice(users, EncodeObjectPtr[testSliceUser]))
+	require.NoError(t, err)
+
+	var decoded []*testSliceUser
+	dec := BorrowDecoder(strings.NewReader(string(data)))
+	defer dec.Release()
+	require.NoError(t, DecodeSlice(dec, &decoded, DecodeObjectPtr[testSliceUser]))
+	assert.Equal(t, users, decoded)
+}
@@ -0,0 +1,376 @@
+package gojay
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MarshalerStream is the interface to implement
+// to continuously encode of stream of data.
+type MarshalerStream interface {
+	MarshalStream(enc *StreamEncoder)
+}
+
+// A StreamEncoder reads and encodes values to JSON from an input stream.
+//
+// It implements context.Context and provides a channel to notify interruption.
+type StreamEncoder struct {
+	*Encoder
+	// opts configure the encoders of the consumers when values are encoded in parallel
+	opts      []EncoderOption
+	mux       sync.RWMutex
+	nConsumer int
+	delimiter byte
+	deadline  *time.Time
+	timer     *time.Timer
+	done      chan struct{}
+	doneErr   error
+	closing   bool
+	jobs      chan streamJob
+	seq       uint64
+}
+
+// streamJob is a value added to the stream waiting to be encoded by a consumer.
+type streamJob struct {
+	seq    uint64
+	encode func(enc *Encoder)
+}
+
+// streamResult is an encoded value waiting to be written in order.
+type streamResult struct {
+	seq uint64
+	enc *Encoder
+}
+
+// EncodeStream starts consuming the MarshalerStream m in its own goroutine, writing each value added
+// by m.MarshalStream to the io.Writer followed by the delimiter.
+//
+// If NConsumer was set to more than one, values are encoded in parallel by as many goroutines
+// but are always written in the order they were added to the StreamEncoder.
+//
+// m must implement MarshalerStream. Ideally m is a channel. See example for implementation.
+//
+// See the documentation for Marshal for details about the conversion of Go value to JSON.
+func (s *StreamEncoder) EncodeStream(m MarshalerStream) {
+	if s.isPooled == 1 {
+		panic(InvalidUsagePooledEncoderError("Invalid usage of pooled encoder"))
+	}
+	if s.deadline != nil {
+		s.mux.Lock()
+		s.timer = time.AfterFunc(time.Until(*s.deadline), func() {
+			s.Cancel(context.DeadlineExceeded)
+		})
+		s.mux.Unlock()
+	}
+	if s.nConsumer <= 1 {
+		go s.consume(m)
+		return
+	}
+	s.jobs = make(chan streamJob, s.nConsumer)
+	results := make(chan streamResult, s.nConsumer)
+	var wg sync.WaitGroup
+	wg.Add(s.nConsumer)
+	for range s.nConsumer {
+		go func() {
+			defer wg.Done()
+			s.encodeJobs(results)
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+	go s.writeResults(results)
+	go s.consume(m)
+}
+
+// LineDelimited sets the delimiter to a new line character.
+//
+// It will add a new line after each JSON marshaled by the MarshalerStream.
+func (s *StreamEncoder) LineDelimited() *StreamEncoder {
+	s.delimiter = '\n'
+	return s
+}
+
+// CommaDelimited sets the delimiter to a comma.
+//
+// It will add a comma after each JSON marshaled by the MarshalerStream.
+func (s *StreamEncoder) CommaDelimited() *StreamEncoder {
+	s.delimiter = ','
+	return s
+}
+
+// Delimiter sets the delimiter to d.
+//
+// It will add d after each JSON marshaled by the MarshalerStream.
+func (s *StreamEncoder) Delimiter(d byte) *StreamEncoder {
+	s.delimiter = d
+	return s
+}
+
+// NConsumer sets the number of goroutines encoding the values of the stream.
+// Values are written in the order they are added whatever the number of consumers.
+func (s *StreamEncoder) NConsumer(n int) *StreamEncoder {
+	s.nConsumer = n
+	return s
+}
+
+// Close stops consuming the MarshalerStream once the current call to MarshalStream returns.
+// Values already added are encoded and written before the Done channel is closed.
+//
+// It is typically called from MarshalStream when the underlying channel is closed.
+func (s *StreamEncoder) Close() {
+	s.mux.Lock()
+	s.closing = true
+	s.mux.Unlock()
+}
+
+// Cancel cancels the consumers of the stream, interrupting the stream encoding.
+// Values which were added but not yet written are discarded.
+//
+// After calling cancel, Done() will return a closed channel.
+func (s *StreamEncoder) Cancel(err error) {
+	s.finish(err)
+}
+
+// context.Context implementation
+
+// Done returns a channel that is closed when work is done.
+// It implements context.Context.
+func (s *StreamEncoder) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err returns nil if Done is not yet closed.
+// If Done is closed, Err returns the error explaining why,
+// or nil if the stream was closed with Close.
+// It implements context.Context.
+func (s *StreamEncoder) Err() error {
+	select {
+	case <-s.done:
+		s.mux.RLock()
+		defer s.mux.RUnlock()
+		return s.doneErr
+	default:
+		return nil
+	}
+}
+
+// Deadline returns the time when work done on behalf of this context
+// should be canceled. Deadline returns ok==false when no deadline is
+// set. Successive calls to Deadline return the same results.
+func (s *StreamEncoder) Deadline() (time.Time, bool) {
+	if s.deadline != nil {
+		return *s.deadline, true
+	}
+	return time.Time{}, false
+}
+
+// SetDeadline sets the deadline after which the stream is cancelled with context.DeadlineExceeded.
+//
+// It must be called before EncodeStream.
+func (s *StreamEncoder) SetDeadline(t time.Time) {
+	s.deadline = &t
+}
+
+// Value implements context.Context.
+func (s *StreamEncoder) Value(_ any) any {
+	return nil
+}
+
+// AddObject adds an object to be encoded, nil objects are skipped.
+// value must implement MarshalerJSONObject.
+func (s *StreamEncoder) AddObject(v MarshalerJSONObject) {
+	s.Object(v)
+}
+
+// Object adds an object to be encoded, nil objects are skipped.
+// value must implement MarshalerJSONObject.
+func (s *StreamEncoder) Object(v MarshalerJSONObject) {
+	if v.IsNil() {
+		return
+	}
+	s.add(func(enc *Encoder) {
+		_, _ = enc.encodeObject(v)
+	})
+}
+
+// AddArray adds an implementation of MarshalerJSONArray to be encoded.
+func (s *StreamEncoder) AddArray(v MarshalerJSONArray) {
+	s.Array(v)
+}
+
+// Array adds an implementation of MarshalerJSONArray to be encoded.
+func (s *StreamEncoder) Array(v MarshalerJSONArray) {
+	s.add(func(enc *Encoder) {
+		_, _ = enc.encodeArray(v)
+	})
+}
+
+// AddString adds a string to be encoded.
+func (s *StreamEncoder) AddString(v string) {
+	s.String(v)
+}
+
+// String adds a string to be encoded.
+func (s *StreamEncoder) String(v string) {
+	s.add(func(enc *Encoder) {
+		_, _ = enc.encodeString(v)
+	})
+}
+
+// AddInt adds an int to be encoded.
+func (s *StreamEncoder) AddInt(v int) {
+	s.Int(v)
+}
+
+// Int adds an int to be encoded.
+func (s *StreamEncoder) Int(v int) {
+	s.add(func(enc *Encoder) {
+		_, _ = enc.encodeInt(v)
+	})
+}
+
+// AddFloat64 adds a float64 to be encoded.
+func (s *StreamEncoder) AddFloat64(v float64) {
+	s.Float64(v)
+}
+
+// Float64 adds a float64 to be encoded.
+func (s *StreamEncoder) Float64(v float64) {
+	s.add(func(enc *Encoder) {
+		_, _ = enc.encodeFloat(v)
+	})
+}
+
+// AddFloat adds a float64 to be encoded.
+func (s *StreamEncoder) AddFloat(v float64) {
+	s.Float64(v)
+}
+
+// Float adds a float64 to be encoded.
+func (s *StreamEncoder) Float(v float64) {
+	s.Float64(v)
+}
+
+// Non exposed
+
+// add encodes a value directly in the buffer of the StreamEncoder
+// or hands it to the consumers if there are several of them.
+func (s *StreamEncoder) add(encode func(enc *Encoder)) {
+	if s.jobs == nil {
+		encode(s.Encoder)
+		s.Encoder.writeByte(s.delimiter)
+		return
+	}
+	select {
+	case s.jobs <- streamJob{seq: s.seq, encode: encode}:
+		s.seq++
+	case <-s.done:
+	}
+}
+
+func (s *StreamEncoder) isClosing() bool {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	return s.closing
+}
+
+func (s *StreamEncoder) finish(err error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	select {
+	case <-s.done:
+	default:
+		s.doneErr = err
+		if s.timer != nil {
+			s.timer.Stop()
+		}
+		close(s.done)
+	}
+}
+
+func (s *StreamEncoder) consume(m MarshalerStream) {
+	for !s.isClosing() {
+		select {
+		case <-s.done:
+			return
+		default:
+		}
+		m.MarshalStream(s)
+		if s.jobs != nil {
+			continue
+		}
+		if s.Encoder.err != nil {
+			s.Cancel(s.Encoder.err)
+			return
+		}
+		if len(s.buf) == 0 {
+			continue
+		}
+		if _, err := s.Encoder.Write(); err != nil {
+			s.Cancel(err)
+			return
+		}
+	}
+	if s.jobs != nil {
+		// consumers and writer close done once everything is written
+		close(s.jobs)
+		return
+	}
+	s.finish(nil)
+}
+
+func (s *StreamEncoder) encodeJobs(results chan<- streamResult) {
+	for {
+		select {
+		case <-s.done:
+			return
+		case job, ok := <-s.jobs:
+			if !ok {
+				return
+			}
+			enc := BorrowEncoder(nil, s.opts...)
+			job.encode(enc)
+			enc.writeByte(s.delimiter)
+			if enc.err != nil {
+				s.Cancel(enc.err)
+				enc.Release()
+				return
+			}
+			select {
+			case results <- streamResult{seq: job.seq, enc: enc}:
+			case <-s.done:
+				enc.Release()
+				return
+			}
+		}
+	}
+}
+
+func (s *StreamEncoder) writeResults(results <-chan streamResult) {
+	var next uint64
+	pending := make(map[uint64]*Encoder)
+	for res := range results {
+		pending[res.seq] = res.enc
+		for enc, ok := pending[next]; ok; enc, ok = pending[next] {
+			delete(pending, next)
+			next++
+			select {
+			case <-s.done:
+			default:
+				// the buffer is indented as it is written if the options have WithIndent
+				enc.w = s.w
+				if _, err := enc.Write(); err != nil {
+					s.Cancel(err)
+				}
+			}
+			enc.Release()
+		}
+	}
+	for _, enc := range pending {
+		enc.Release()
+	}
+	s.finish(nil)
+}
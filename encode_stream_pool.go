@@ -0,0 +1,70 @@
+package gojay
+
+import (
+	"io"
+	"sync"
+)
+
+var streamEncPool = sync.Pool{
+	New: func() any { return Stream.NewEncoder(nil) },
+}
+
+// NewEncoder returns a new StreamEncoder.
+// It takes an io.Writer implementation to output data and options configuring the encoder,
+// which apply to the encoders of all the consumers.
+// It initiates the done channel returned by Done().
+//
+// By default values are line delimited and encoded by a single consumer.
+func (s stream) NewEncoder(w io.Writer, opts ...EncoderOption) *StreamEncoder {
+	return &StreamEncoder{
+		Encoder:   NewEncoder(w, opts...),
+		opts:      opts,
+		nConsumer: 1,
+		delimiter: '\n',
+		done:      make(chan struct{}),
+	}
+}
+
+// BorrowEncoder borrows a StreamEncoder from the pool.
+// It takes an io.Writer implementation to output data and options configuring the encoder,
+// which apply to the encoders of all the consumers.
+// It initiates the done channel returned by Done().
+//
+// If no StreamEncoder is available in the pool, it returns a fresh one.
+func (s stream) BorrowEncoder(w io.Writer, opts ...EncoderOption) *StreamEncoder {
+	//nolint:forcetypeassert
+	streamEnc := streamEncPool.Get().(*StreamEncoder)
+	streamEnc.w = w
+	streamEnc.buf = streamEnc.buf[:0]
+	streamEnc.err = nil
+	streamEnc.isPooled = 0
+	streamEnc.hasKeys = false
+	streamEnc.keys = nil
+	streamEnc.indented = false
+	streamEnc.prefix = ""
+	streamEnc.indent = ""
+	streamEnc.htmlSafe = false
+	streamEnc.utf8Policy = EncodeUTF8Unchecked
+	streamEnc.applyOptions(opts)
+	streamEnc.opts = opts
+	streamEnc.nConsumer = 1
+	streamEnc.delimiter = '\n'
+	streamEnc.deadline = nil
+	streamEnc.timer = nil
+	streamEnc.done = make(chan struct{})
+	streamEnc.doneErr = nil
+	streamEnc.closing = false
+	streamEnc.jobs = nil
+	streamEnc.seq = 0
+	return streamEnc
+}
+
+// Release sends back a StreamEncoder to the pool.
+// If an encoder is used after calling Release
+// a panic will be raised with an InvalidUsagePooledEncoderError error.
+//
+// Release must only be called once the Done channel is closed.
+func (s *StreamEncoder) Release() {
+	s.isPooled = 1
+	streamEncPool.Put(s)
+}
@@ -0,0 +1,285 @@
+package gojay
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Verify StreamEncoder implements context.Context.
+var _ context.Context = &StreamEncoder{}
+
+type streamEncChan chan any
+
+func (s streamEncChan) MarshalStream(enc *StreamEncoder) {
+	select {
+	case <-enc.Done():
+		return
+	case v, ok := <-s:
+		if !ok {
+			enc.Close()
+			return
+		}
+		switch vt := v.(type) {
+		case *testObject:
+			enc.AddObject(vt)
+		case TestEncodingArrStrings:
+			enc.AddArray(vt)
+		case string:
+			enc.AddString(vt)
+		case int:
+			enc.AddInt(vt)
+		case float64:
+			enc.AddFloat(vt)
+		case MarshalerJSONObject:
+			enc.Object(vt)
+		}
+	}
+}
+
+type safeBuffer struct {
+	mux sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *safeBuffer) Write(p []byte) (int, error) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *safeBuffer) String() string {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	return b.buf.String()
+}
+
+type failingWriter struct {
+	err error
+}
+
+func (w failingWriter) Write(_ []byte) (int, error) {
+	return 0, w.err
+}
+
+func waitStreamEncoder(t *testing.T, enc *StreamEncoder) {
+	t.Helper()
+
+	select {
+	case <-enc.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream encoder should have closed done channel")
+	}
+}
+
+func TestStreamEncoding(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		values   []any
+		setup    func(enc *StreamEncoder) *StreamEncoder
+		expected string
+	}{
+		{
+			name:     "objects-line-delimited",
+			values:   []any{&testObject{testStr: "a"}, (*testObject)(nil), &testObject{testStr: "b", testInt: 1}},
+			setup:    (*StreamEncoder).LineDelimited,
+			expected: "{\"testStr\":\"a\",\"testInt\":0,\"testInt64\":0,\"testInt32\":0,\"testInt16\":0,\"testInt8\":0,\"testUint64\":0,\"testUint32\":0,\"testUint16\":0,\"testUint8\":0,\"testFloat64\":0,\"testFloat32\":0,\"testBool\":false}\n{\"testStr\":\"b\",\"testInt\":1,\"testInt64\":0,\"testInt32\":0,\"testInt16\":0,\"testInt8\":0,\"testUint64\":0,\"testUint32\":0,\"testUint16\":0,\"testUint8\":0,\"testFloat64\":0,\"testFloat32\":0,\"testBool\":false}\n",
+		},
+		{
+			name:     "mixed-comma-delimited",
+			values:   []any{"hello \"world\"", 1, 1.5, TestEncodingArrStrings{"a", "b"}},
+			setup:    (*StreamEncoder).CommaDelimited,
+			expected: `"hello \"world\"",1,1.5,["a","b"],`,
+		},
+		{
+			name:   "custom-delimiter",
+			values: []any{EncodeObjectFunc(func(enc *Encoder) { enc.IntKey("id", 1) }), 2},
+			setup: func(enc *StreamEncoder) *StreamEncoder {
+				return enc.Delimiter('\t')
+			},
+			expected: "{\"id\":1}\t2\t",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			buf := &safeBuffer{}
+			enc := testCase.setup(Stream.NewEncoder(buf))
+			s := streamEncChan(make(chan any))
+			enc.EncodeStream(s)
+			for _, v := range testCase.values {
+				s <- v
+			}
+			close(s)
+			waitStreamEncoder(t, enc)
+			require.NoError(t, enc.Err())
+			assert.Equal(t, testCase.expected, buf.String())
+		})
+	}
+}
+
+func TestStreamEncodingOptions(t *testing.T) {
+	t.Parallel()
+
+	obj := EncodeObjectFunc(func(enc *Encoder) {
+		enc.StringKey("<", "a\xff")
+		enc.ArrayKey("l", TestEncodingArrStrings{"b"})
+	})
+	opts := []EncoderOption{WithIndent("", "  "), WithHTMLSafe(), WithEncoderUTF8Policy(EncodeUTF8Replace)}
+	const expected = "{\n  \"\\u003c\": \"a\uFFFD\",\n  \"l\": [\n    \"b\"\n  ]\n}\n"
+	for _, nConsumer := range []int{1, 4} {
+		for _, borrow := range []bool{false, true} {
+			buf := &safeBuffer{}
+			enc := Stream.NewEncoder(buf, opts...)
+			if borrow {
+				enc = Stream.BorrowEncoder(buf, opts...)
+			}
+			enc.NConsumer(nConsumer)
+			s := streamEncChan(make(chan any))
+			enc.EncodeStream(s)
+			s <- obj
+			s <- obj
+			close(s)
+			waitStreamEncoder(t, enc)
+			require.NoError(t, enc.Err())
+			assert.Equal(t, expected+expected, buf.String(), "%d consumers", nConsumer)
+		}
+	}
+}
+
+func TestStreamEncodingNConsumerPreservesOrder(t *testing.T) {
+	t.Parallel()
+
+	const n = 2000
+	buf := &safeBuffer{}
+	enc := Stream.BorrowEncoder(buf).NConsumer(8).LineDelimited()
+	s := streamEncChan(make(chan any))
+	enc.EncodeStream(s)
+	expected := strings.Builder{}
+	for i := range n {
+		if i%2 == 0 {
+			s <- i
+			expected.WriteString(strconv.Itoa(i))
+		} else {
+			s <- "v" + strconv.Itoa(i)
+			expected.WriteString(`"v` + strconv.Itoa(i) + `"`)
+		}
+		expected.WriteByte('\n')
+	}
+	close(s)
+	waitStreamEncoder(t, enc)
+	require.NoError(t, enc.Err())
+	assert.Equal(t, expected.String(), buf.String())
+	enc.Release()
+}
+
+func TestStreamEncodingWriterError(t *testing.T) {
+	t.Parallel()
+
+	for _, nConsumer := range []int{1, 4} {
+		t.Run("consumers-"+strconv.Itoa(nConsumer), func(t *testing.T) {
+			t.Parallel()
+
+			writeErr := errors.New("write error")
+			enc := Stream.NewEncoder(failingWriter{writeErr}).NConsumer(nConsumer)
+			s := streamEncChan(make(chan any))
+			enc.EncodeStream(s)
+			go func() {
+				for i := 0; ; i++ {
+					select {
+					case s <- i:
+					case <-enc.Done():
+						return
+					}
+				}
+			}()
+			waitStreamEncoder(t, enc)
+			assert.Equal(t, writeErr, enc.Err())
+		})
+	}
+}
+
+func TestStreamEncodingMarshalError(t *testing.T) {
+	t.Parallel()
+
+	for _, nConsumer := range []int{1, 4} {
+		t.Run("consumers-"+strconv.Itoa(nConsumer), func(t *testing.T) {
+			t.Parallel()
+
+			enc := Stream.NewEncoder(&safeBuffer{}).NConsumer(nConsumer)
+			s := streamEncChan(make(chan any, 1))
+			s <- EncodeObjectFunc(func(enc *Encoder) {
+				enc.AddInterfaceKey("test", struct{}{})
+			})
+			enc.EncodeStream(s)
+			waitStreamEncoder(t, enc)
+			require.Error(t, enc.Err())
+			assert.IsType(t, InvalidMarshalError(""), enc.Err())
+		})
+	}
+}
+
+func TestStreamEncodingCancel(t *testing.T) {
+	t.Parallel()
+
+	for _, nConsumer := range []int{1, 4} {
+		t.Run("consumers-"+strconv.Itoa(nConsumer), func(t *testing.T) {
+			t.Parallel()
+
+			buf := &safeBuffer{}
+			enc := Stream.NewEncoder(buf).NConsumer(nConsumer)
+			s := streamEncChan(make(chan any))
+			enc.EncodeStream(s)
+			s <- 1
+			assert.NoError(t, enc.Err())
+			cancelErr := errors.New("cancelled")
+			enc.Cancel(cancelErr)
+			waitStreamEncoder(t, enc)
+			assert.Equal(t, cancelErr, enc.Err())
+			enc.Cancel(errors.New("other"))
+			assert.Equal(t, cancelErr, enc.Err())
+		})
+	}
+}
+
+func TestStreamEncodingDeadline(t *testing.T) {
+	t.Parallel()
+
+	enc := Stream.NewEncoder(&safeBuffer{})
+	_, ok := enc.Deadline()
+	assert.False(t, ok)
+	deadline := time.Now().Add(20 * time.Millisecond)
+	enc.SetDeadline(deadline)
+	d, ok := enc.Deadline()
+	assert.True(t, ok)
+	assert.Equal(t, deadline, d)
+	enc.EncodeStream(streamEncChan(make(chan any)))
+	waitStreamEncoder(t, enc)
+	assert.Equal(t, context.DeadlineExceeded, enc.Err())
+	assert.Nil(t, enc.Value("key"))
+}
+
+func TestStreamEncoderPool(t *testing.T) {
+	t.Parallel()
+
+	enc := Stream.NewEncoder(&safeBuffer{})
+	enc.isPooled = 1
+	assert.PanicsWithValue(t, InvalidUsagePooledEncoderError("Invalid usage of pooled encoder"), func() {
+		enc.EncodeStream(streamEncChan(make(chan any)))
+	})
+	enc = Stream.BorrowEncoder(&safeBuffer{})
+	assert.Equal(t, 1, enc.nConsumer)
+	assert.Equal(t, byte('\n'), enc.delimiter)
+	assert.NoError(t, enc.Err())
+}
@@ -5,8 +5,11 @@ func (enc *Encoder) EncodeString(s string) error {
 	if enc.isPooled == 1 {
 		panic(InvalidUsagePooledEncoderError("Invalid usage of pooled encoder"))
 	}
-	_, _ = enc.encodeString(s)
-	_, err := enc.Write()
+	_, err := enc.encodeString(s)
+	if err != nil {
+		return err
+	}
+	_, err = enc.Write()
 	if err != nil {
 		enc.err = err
 		return err
@@ -19,7 +22,7 @@ func (enc *Encoder) encodeString(v string) ([]byte, error) {
 	enc.writeByte('"')
 	enc.writeStringEscape(v)
 	enc.writeByte('"')
-	return enc.buf, nil
+	return enc.buf, enc.err
 }
 
 // AppendString appends a string to the buffer.
@@ -2,9 +2,11 @@ package gojay
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type TestWriterError string
@@ -51,3 +53,75 @@ func TestBuf(t *testing.T) {
 	enc.buf = b
 	assert.Equal(t, b, enc.Buf(), "enc.Buf() should equal to b")
 }
+
+var errTestRequired = errors.New("required field is nil")
+
+// testErrObject sets an error when its user is nil.
+type testErrObject struct {
+	user  *testSliceUser
+	calls *int
+}
+
+func (o *testErrObject) MarshalJSONObject(enc *Encoder) {
+	*o.calls++
+	if o.user == nil {
+		enc.SetError(errTestRequired)
+		return
+	}
+	enc.ObjectKey("user", o.user)
+}
+
+func (o *testErrObject) IsNil() bool {
+	return o == nil
+}
+
+func TestEncoderSetError(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+	parent := EncodeSlice([]*testErrObject{
+		{user: &testSliceUser{id: 1}, calls: &calls},
+		{calls: &calls},
+		{user: &testSliceUser{id: 3}, calls: &calls},
+	}, EncodeObjectPtr[testErrObject])
+
+	b, err := Marshal(parent)
+	require.ErrorIs(t, err, errTestRequired)
+	assert.Nil(t, b, "the partial encoding is not returned")
+	assert.Equal(t, 2, calls, "the marshalers after the error are not called")
+	b, err = MarshalIndent(parent, "", " ")
+	require.ErrorIs(t, err, errTestRequired)
+	assert.Nil(t, b)
+
+	builder := &strings.Builder{}
+	enc := BorrowEncoder(builder)
+	defer enc.Release()
+	require.ErrorIs(t, enc.EncodeArray(parent), errTestRequired)
+	require.ErrorIs(t, enc.Err(), errTestRequired)
+	assert.Empty(t, builder.String(), "nothing is written")
+	require.ErrorIs(t, enc.EncodeObject(&testSliceUser{id: 2}), errTestRequired, "the Encoder stays failed")
+	assert.Empty(t, builder.String())
+
+	b, err = MarshalJSONArray(parent)
+	require.ErrorIs(t, err, errTestRequired)
+	assert.Nil(t, b)
+
+	// the error of a nested object is returned by the encoding of its parent
+	obj := EncodeMap(map[string]*testErrObject{"a": {calls: &calls}}, func(enc *Encoder, k string, v *testErrObject) {
+		enc.ObjectKey(k, v)
+	})
+	b, err = MarshalJSONObject(obj)
+	require.ErrorIs(t, err, errTestRequired)
+	assert.Nil(t, b)
+	enc = BorrowEncoder(builder)
+	defer enc.Release()
+	require.ErrorIs(t, enc.EncodeObject(obj), errTestRequired)
+	assert.Empty(t, builder.String())
+
+	errOther := errors.New("other")
+	enc = BorrowEncoder(builder)
+	defer enc.Release()
+	enc.SetError(errTestRequired)
+	enc.SetError(errOther)
+	assert.ErrorIs(t, enc.Err(), errTestRequired, "the first error is kept")
+}
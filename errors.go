@@ -3,6 +3,9 @@ package gojay
 import (
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
 )
 
 const invalidJSONCharErrorMsg = "Invalid JSON, wrong char '%c' found at position %d"
@@ -16,17 +19,54 @@ func (err InvalidJSONError) Error() string {
 }
 
 func (dec *Decoder) raiseInvalidJSONErr(pos int) error {
+	return dec.raiseUnexpectedErr(pos, "")
+}
+
+// raiseUnexpectedErr is raiseInvalidJSONErr with a description of the token expected at pos.
+func (dec *Decoder) raiseUnexpectedErr(pos int, expected string) error {
+	// the input is truncated as reading failed, this is the error to report
+	if dec.readErr != nil {
+		dec.err = dec.readErr
+		return dec.err
+	}
+	// the buffer may hold stale data past its length
 	var c byte
-	if len(dec.data) > pos {
+	if pos < dec.length && pos < len(dec.data) {
 		c = dec.data[pos]
 	}
-	dec.err = InvalidJSONError(
+	err := dec.makeDecodeErr(nil, pos, expected, dec.foundChar(pos))
+	// the position of the message is the offset in the input, not in the buffer
+	err.Err = InvalidJSONError(
 		fmt.Sprintf(
 			invalidJSONCharErrorMsg,
 			c,
-			pos,
+			err.Offset,
 		),
 	)
+	dec.err = err
+	return dec.err
+}
+
+const (
+	invalidUTF8ErrorMsg       = "Invalid UTF-8 encoding"
+	invalidUTF8EncodeErrorMsg = "Invalid UTF-8 encoding, byte 0x%x at index %d of a string"
+)
+
+// InvalidUTF8Error is a type representing an error returned when
+// a string is not valid UTF-8 or has an unpaired surrogate escape sequence.
+type InvalidUTF8Error string
+
+func (err InvalidUTF8Error) Error() string {
+	return string(err)
+}
+
+// raiseInvalidUTF8Err returns an InvalidUTF8Error located at pos.
+func (dec *Decoder) raiseInvalidUTF8Err(pos int, msg, expected string) error {
+	if dec.readErr != nil {
+		dec.err = dec.readErr
+		return dec.err
+	}
+	dec.err = dec.makeDecodeErr(InvalidUTF8Error(msg), pos, expected, dec.foundChar(pos))
 	return dec.err
 }
 
@@ -41,14 +81,138 @@ func (err InvalidUnmarshalError) Error() string {
 }
 
 func (dec *Decoder) makeInvalidUnmarshalErr(v any) error {
-	return InvalidUnmarshalError(
-		fmt.Sprintf(
-			invalidUnmarshalErrorMsg,
-			v,
+	return dec.makeDecodeErr(
+		InvalidUnmarshalError(
+			fmt.Sprintf(
+				invalidUnmarshalErrorMsg,
+				v,
+			),
 		),
+		dec.cursor,
+		fmt.Sprintf("%T", v),
+		dec.foundKind(dec.cursor),
+	)
+}
+
+const invalidArrayLengthErrorMsg = "Cannot unmarshal JSON array to an array of %d elements"
+
+// makeArrayLengthErr returns an InvalidUnmarshalError located at pos
+// for a JSON array which doesn't have the length elements of a fixed-size array.
+func (dec *Decoder) makeArrayLengthErr(pos, length int, found string) error {
+	return dec.makeDecodeErr(
+		InvalidUnmarshalError(fmt.Sprintf(invalidArrayLengthErrorMsg, length)),
+		pos,
+		fmt.Sprintf("%d elements", length),
+		found,
 	)
 }
 
+// skipInvalidUnmarshal sets an InvalidUnmarshalError for v and skips the value at the cursor,
+// it is used when the JSON value doesn't fit v but decoding can go on.
+func (dec *Decoder) skipInvalidUnmarshal(v any) error {
+	dec.err = dec.makeInvalidUnmarshalErr(v)
+	if err := dec.skipData(); err != nil {
+		return err
+	}
+	dec.called |= 1
+	return nil
+}
+
+// DecodeError is the error returned when decoding fails at a given position of the input.
+// It wraps the underlying error, an InvalidJSONError for malformed JSON
+// or an InvalidUnmarshalError when a JSON value doesn't fit the receiver type,
+// use errors.As to retrieve either of them or the DecodeError itself.
+type DecodeError struct {
+	// Offset is the number of bytes of the input preceding the failure.
+	Offset int
+	// Line and Column locate the failure in the input, both start at 1,
+	// Column counts bytes from the start of the line.
+	Line   int
+	Column int
+	// Path is the JSON path of the value being decoded, like $.items[3].price.
+	Path string
+	// Expected describes what the decoder was looking for, it may be empty.
+	Expected string
+	// Found describes what was found instead, EOF if the input ended.
+	Found string
+	// Err is the underlying error.
+	Err error
+}
+
+func (err *DecodeError) Error() string {
+	b := strings.Builder{}
+	b.WriteString(err.Err.Error())
+	b.WriteString(" (line ")
+	b.WriteString(strconv.Itoa(err.Line))
+	b.WriteString(", column ")
+	b.WriteString(strconv.Itoa(err.Column))
+	b.WriteString(", offset ")
+	b.WriteString(strconv.Itoa(err.Offset))
+	b.WriteString(", path ")
+	b.WriteString(err.Path)
+	if err.Expected != "" {
+		b.WriteString(", expected ")
+		b.WriteString(err.Expected)
+		b.WriteString(", found ")
+		b.WriteString(err.Found)
+	}
+	b.WriteByte(')')
+	return b.String()
+}
+
+// Unwrap returns the underlying error.
+func (err *DecodeError) Unwrap() error {
+	return err.Err
+}
+
+// makeDecodeErr wraps err in a DecodeError located at the byte pos of the buffer.
+func (dec *Decoder) makeDecodeErr(err error, pos int, expected, found string) *DecodeError {
+	p := dec.position(pos)
+	return &DecodeError{
+		Offset:   p.offset,
+		Line:     p.line + 1,
+		Column:   p.column + 1,
+		Path:     dec.jsonPath(),
+		Expected: expected,
+		Found:    found,
+		Err:      err,
+	}
+}
+
+// foundChar describes the char at pos for a DecodeError.
+func (dec *Decoder) foundChar(pos int) string {
+	if pos >= dec.length || pos >= len(dec.data) {
+		return "EOF"
+	}
+	if c := dec.data[pos]; c >= utf8.RuneSelf {
+		return fmt.Sprintf("'\\x%02x'", c)
+	}
+	return strconv.QuoteRune(rune(dec.data[pos]))
+}
+
+// foundKind describes the kind of the JSON value starting at pos for a DecodeError.
+func (dec *Decoder) foundKind(pos int) string {
+	if pos >= dec.length || pos >= len(dec.data) {
+		return "EOF"
+	}
+	switch dec.data[pos] {
+	case '{':
+		return "object"
+	case '[':
+		return "array"
+	case '"':
+		return "string"
+	case 't', 'f':
+		return "boolean"
+	case 'n':
+		return "null"
+	case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		return "number"
+	default:
+		return dec.foundChar(pos)
+	}
+}
+
 const invalidMarshalErrorMsg = "Invalid type %T provided to Marshal"
 
 // InvalidMarshalError is a type representing an error returned when
@@ -82,6 +82,27 @@ func encodeAPI(m myMap) error {
 	return nil
 }
 
+// Using the map helpers, no custom type is needed
+func helpersAPI(m map[string]string) error {
+	// keys are sorted for a deterministic output
+	b, err := gojay.Marshal(gojay.EncodeMap(m, (*gojay.Encoder).StringKey).Sorted())
+	if err != nil {
+		return err
+	}
+	log.Print(string(b))
+
+	var nM map[string]string
+	dec := gojay.BorrowDecoder(strings.NewReader(string(b)))
+	defer dec.Release()
+	// MapStringString is a shortcut for gojay.DecodeMap(dec, &nM, (*gojay.Decoder).String)
+	err = dec.MapStringString(&nM)
+	if err != nil {
+		return err
+	}
+	log.Print(nM)
+	return nil
+}
+
 func main() {
 	// make our map to be encoded
 	m := myMap(map[string]string{
@@ -97,4 +118,8 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
+	err = helpersAPI(m)
+	if err != nil {
+		log.Fatal(err)
+	}
 }
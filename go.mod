@@ -1,6 +1,6 @@
 module github.com/arago-dsp/gojay
 
-go 1.22
+go 1.23
 
 require github.com/stretchr/testify v1.9.0
 
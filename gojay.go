@@ -6,5 +6,5 @@
 // to decode and encode structures, slices, arrays and even channels.
 //
 // On top of the simple interfaces to implement, gojay provides lots of helpers to decode and encode
-// multiple of different types natively such as bit.Int, sql.NullString or time.Time
+// multiple of different types natively such as big.Int, sql.NullString or time.Time
 package gojay
@@ -0,0 +1,101 @@
+package gojay
+
+import (
+	"math/big"
+	"strconv"
+)
+
+// Number is a JSON number kept as its literal, like json.Number.
+// It passes numbers through without knowing their type up front and without losing precision.
+type Number string
+
+// String returns the literal of the number.
+func (n Number) String() string {
+	return string(n)
+}
+
+// Valid reports whether n follows the JSON number grammar.
+//
+//nolint:cyclop
+func (n Number) Valid() bool {
+	s := string(n)
+	i := 0
+	if i < len(s) && s[i] == '-' {
+		i++
+	}
+	// integer part, leading zeros are invalid
+	switch {
+	case i < len(s) && s[i] == '0':
+		i++
+	case i < len(s) && isDigit(s[i]):
+		for i < len(s) && isDigit(s[i]) {
+			i++
+		}
+	default:
+		return false
+	}
+	// fraction part
+	if i < len(s) && s[i] == '.' {
+		i++
+		if i == len(s) || !isDigit(s[i]) {
+			return false
+		}
+		for i < len(s) && isDigit(s[i]) {
+			i++
+		}
+	}
+	// exponent part
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		i++
+		if i < len(s) && (s[i] == '-' || s[i] == '+') {
+			i++
+		}
+		if i == len(s) || !isDigit(s[i]) {
+			return false
+		}
+		for i < len(s) && isDigit(s[i]) {
+			i++
+		}
+	}
+	return i == len(s)
+}
+
+// Int64 returns the number as an int64, it fails if the number is not an integer or overflows an int64.
+func (n Number) Int64() (int64, error) {
+	if !n.Valid() {
+		return 0, n.syntaxErr("Int64")
+	}
+	return strconv.ParseInt(string(n), 10, 64)
+}
+
+// Uint64 returns the number as a uint64, it fails if the number is not a positive integer or overflows a uint64.
+func (n Number) Uint64() (uint64, error) {
+	if !n.Valid() {
+		return 0, n.syntaxErr("Uint64")
+	}
+	return strconv.ParseUint(string(n), 10, 64)
+}
+
+// Float64 returns the number as a float64, it fails if the number overflows a float64.
+func (n Number) Float64() (float64, error) {
+	if !n.Valid() {
+		return 0, n.syntaxErr("Float64")
+	}
+	return strconv.ParseFloat(string(n), 64)
+}
+
+// BigInt returns the number as a *big.Int, it fails if the number is not an integer.
+func (n Number) BigInt() (*big.Int, error) {
+	if !n.Valid() {
+		return nil, n.syntaxErr("BigInt")
+	}
+	i, ok := new(big.Int).SetString(string(n), 10)
+	if !ok {
+		return nil, n.syntaxErr("BigInt")
+	}
+	return i, nil
+}
+
+func (n Number) syntaxErr(fn string) error {
+	return &strconv.NumError{Func: fn, Num: string(n), Err: strconv.ErrSyntax}
+}
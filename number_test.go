@@ -0,0 +1,55 @@
+package gojay
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNumberValid(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []Number{"0", "-0", "1", "-12", "1.5", "0.25", "1e3", "1E+3", "-1.5e-30", "12345678901234567890123"} {
+		assert.True(t, n.Valid(), n)
+	}
+	for _, n := range []Number{"", "-", "01", "+1", "1.", ".5", "1e", "1e+", "0x1", "1_000", "Inf", "NaN", " 1", "1 "} {
+		assert.False(t, n.Valid(), n)
+	}
+}
+
+func TestNumberConversions(t *testing.T) {
+	t.Parallel()
+
+	i, err := Number("-9223372036854775808").Int64()
+	require.NoError(t, err)
+	assert.Equal(t, int64(-9223372036854775808), i)
+	_, err = Number("9223372036854775808").Int64()
+	require.ErrorIs(t, err, strconv.ErrRange)
+	_, err = Number("1.5").Int64()
+	require.ErrorIs(t, err, strconv.ErrSyntax)
+
+	u, err := Number("18446744073709551615").Uint64()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(18446744073709551615), u)
+	_, err = Number("-1").Uint64()
+	require.ErrorIs(t, err, strconv.ErrSyntax)
+
+	f, err := Number("-1.5e3").Float64()
+	require.NoError(t, err)
+	assert.InDelta(t, -1500.0, f, 0)
+	_, err = Number("1e400").Float64()
+	require.ErrorIs(t, err, strconv.ErrRange)
+	_, err = Number("Inf").Float64()
+	require.ErrorIs(t, err, strconv.ErrSyntax, "only the JSON number grammar is accepted")
+
+	b, err := Number("-340282366920938463463374607431768211455").BigInt()
+	require.NoError(t, err)
+	assert.Equal(t, "-340282366920938463463374607431768211455", b.String())
+	_, err = Number("1e3").BigInt()
+	var numErr *strconv.NumError
+	require.ErrorAs(t, err, &numErr)
+	assert.Equal(t, "BigInt", numErr.Func)
+	assert.Equal(t, "1e3", numErr.Num)
+}
@@ -0,0 +1,6 @@
+//go:build race
+
+package gojay
+
+// raceEnabled is true when the tests are run with the race detector.
+const raceEnabled = true